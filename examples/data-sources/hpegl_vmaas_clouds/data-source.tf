@@ -0,0 +1,5 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+data "hpegl_vmaas_clouds" "vmware" {
+  type_code = "vmware"
+}
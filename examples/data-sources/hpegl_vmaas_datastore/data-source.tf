@@ -4,3 +4,10 @@ data "hpegl_vmaas_datastore" "c_3par" {
   cloud_id = data.hpegl_vmaas_cloud.cloud.id
   name     = "Compute-3par-A64G-FC-1TB"
 }
+
+data "hpegl_vmaas_datastore" "most_free" {
+  cloud_id        = data.hpegl_vmaas_cloud.cloud.id
+  type            = "vmfs"
+  min_free_gb     = 100
+  most_free_space = true
+}
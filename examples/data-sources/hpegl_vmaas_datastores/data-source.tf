@@ -0,0 +1,5 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+data "hpegl_vmaas_datastores" "cloud_datastores" {
+  cloud_id = data.hpegl_vmaas_cloud.cloud.id
+}
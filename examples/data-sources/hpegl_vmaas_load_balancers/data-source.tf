@@ -0,0 +1,5 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+data "hpegl_vmaas_load_balancers" "cloud_lbs" {
+  cloud_id = data.hpegl_vmaas_cloud.cloud.id
+}
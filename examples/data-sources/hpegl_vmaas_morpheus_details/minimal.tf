@@ -1,10 +1,19 @@
 # (C) Copyright 2024 Hewlett Packard Enterprise Development LP
 
-data "hpegl_vmaas_morpheus_details" "morpheus_details" {}
+data "hpegl_vmaas_morpheus_details" "morpheus_details" {
+  # fetch a new access_token if it expires within 10 minutes
+  refresh_before = "10m"
+}
 
 provider "morpheus" {
   url          = data.hpegl_vmaas_morpheus_details.morpheus_details.url
   access_token = data.hpegl_vmaas_morpheus_details.morpheus_details.access_token
 }
 
+output "morpheus_token_expires_at" {
+  value = data.hpegl_vmaas_morpheus_details.morpheus_details.expires_at
+}
 
+output "morpheus_version" {
+  value = data.hpegl_vmaas_morpheus_details.morpheus_details.cmp_version
+}
@@ -3,3 +3,7 @@
 data "hpegl_vmaas_network" "blue_net" {
   name = "Blue-Net"
 }
+
+output "blue_net_cidr" {
+  value = data.hpegl_vmaas_network.blue_net.cidr
+}
@@ -0,0 +1,9 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+data "hpegl_vmaas_networks" "cloud_networks" {
+  cloud_id = data.hpegl_vmaas_cloud.cloud.id
+}
+
+output "cloud_network_cidrs" {
+  value = [for n in data.hpegl_vmaas_networks.cloud_networks.networks : n.cidr]
+}
@@ -3,3 +3,9 @@
 data "hpegl_vmaas_plan" "g1_small" {
   name = "G1-Small"
 }
+
+data "hpegl_vmaas_plan" "four_cores" {
+  provision_type = "vmware"
+  min_cores      = 4
+  min_memory_gb  = 8
+}
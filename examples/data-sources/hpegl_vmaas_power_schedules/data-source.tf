@@ -0,0 +1,4 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+data "hpegl_vmaas_power_schedules" "all" {
+}
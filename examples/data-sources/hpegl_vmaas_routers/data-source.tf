@@ -0,0 +1,5 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+data "hpegl_vmaas_routers" "cloud_routers" {
+  cloud_id = data.hpegl_vmaas_cloud.cloud.id
+}
@@ -3,3 +3,23 @@
 data "hpegl_vmaas_template" "vanilla" {
   name = "vanilla-centos7-x86_64-09072020"
 }
+
+data "hpegl_vmaas_template" "latest_centos" {
+  name_regex  = "^vanilla-centos7-.*"
+  most_recent = true
+
+  filter {
+    name   = "image_type"
+    values = ["vmware"]
+  }
+}
+
+data "hpegl_vmaas_template" "golden_rhel9" {
+  name_prefix = "rhel9-"
+  os_type     = "rhel.9.64"
+  most_recent = true
+
+  tags = {
+    golden = "yes"
+  }
+}
@@ -0,0 +1,8 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_distributed_firewall_policy" "tf_app_policy" {
+  name        = "tf_app_policy"
+  description = "Distributed firewall policy created via terraform"
+  category    = "Application"
+  priority    = 10
+}
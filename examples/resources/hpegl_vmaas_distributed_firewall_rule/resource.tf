@@ -0,0 +1,18 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_distributed_firewall_rule" "tf_allow_web" {
+  name               = "tf_allow_web"
+  policy_id          = hpegl_vmaas_distributed_firewall_policy.tf_app_policy.id
+  network_server_id  = hpegl_vmaas_distributed_firewall_policy.tf_app_policy.network_server_id
+  description        = "Allow HTTPS to web tier, created via terraform"
+  action             = "ALLOW"
+  direction          = "IN"
+  logging            = true
+  source_addresses   = ["10.20.0.0/16"]
+  destination_groups = [hpegl_vmaas_nsx_security_group.tf_web_tier.path]
+  applied_to         = [hpegl_vmaas_nsx_security_group.tf_web_tier.path]
+  ports {
+    protocol          = "TCP"
+    destination_ports = ["443"]
+  }
+}
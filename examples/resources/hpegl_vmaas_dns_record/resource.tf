@@ -0,0 +1,23 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+# A record of a reserved load balancer VIP
+resource "hpegl_vmaas_dns_record" "tf_vip" {
+  domain_id = hpegl_vmaas_network_domain.tf_domain.id
+  name      = hpegl_vmaas_ip_address.tf_vip.hostname
+  type      = "A"
+  content   = hpegl_vmaas_ip_address.tf_vip.ip_address
+}
+
+resource "hpegl_vmaas_dns_record" "tf_vip_ptr" {
+  domain_id = hpegl_vmaas_network_domain.tf_domain.id
+  name      = "20.0.100.10.in-addr.arpa"
+  type      = "PTR"
+  content   = hpegl_vmaas_dns_record.tf_vip.fqdn
+}
+
+resource "hpegl_vmaas_dns_record" "tf_www" {
+  domain_id = hpegl_vmaas_network_domain.tf_domain.id
+  name      = "www"
+  type      = "CNAME"
+  content   = hpegl_vmaas_dns_record.tf_vip.fqdn
+}
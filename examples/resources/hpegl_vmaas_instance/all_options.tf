@@ -71,6 +71,16 @@ resource "hpegl_vmaas_instance" "tf_instance" {
   # Restarts the instance if set to any positive integer.
   # Restart works only on pre-created instance.`,
   restart_instance = 1
+  # Powers off the instance while resizing plan, volumes or networks and
+  # restores the original power state afterwards.
+  allow_stop_for_update = true
+  # Options used while deleting the instance. deletion_protection should be
+  # set to false and applied before destroying the instance.
+  deletion_protection = false
+  preserve_volumes    = false
+  remove_backups      = true
+  release_ips         = true
+  force               = false
   # any update in snapshot will end up to creating new snapshot and existing
   # snapshot will be still in backend.
   snapshot {
@@ -0,0 +1,20 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+# Restart the instance whenever the plan of the instance changes
+resource "hpegl_vmaas_instance_action" "tf_restart" {
+  instance_id = hpegl_vmaas_instance.tf_instance.id
+  action      = "restart"
+  triggers = {
+    plan_id = hpegl_vmaas_instance.tf_instance.plan_id
+  }
+}
+
+# Run a Morpheus workflow on the instance
+resource "hpegl_vmaas_instance_action" "tf_workflow" {
+  instance_id = hpegl_vmaas_instance.tf_instance.id
+  action      = "workflow"
+  workflow_id = 12
+  custom_options = {
+    app_version = "1.2.0"
+  }
+}
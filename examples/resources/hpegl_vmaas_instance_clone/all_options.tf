@@ -4,6 +4,8 @@
 # Clone a instance from an existing instance
 resource "hpegl_vmaas_instance_clone" "tf_instance_clone" {
   source_instance_id = hpegl_vmaas_instance.tf_instance.id
+  # Optional snapshot of the source instance to clone from
+  # source_snapshot_id = 12
   name               = "tf_clone"
   cloud_id           = data.hpegl_vmaas_cloud.cloud.id
   group_id           = data.hpegl_vmaas_group.default_group.id
@@ -0,0 +1,39 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+# Create 20 identical worker instances named worker-01 to worker-20
+resource "hpegl_vmaas_instance_group" "tf_workers" {
+  name_pattern   = "worker-%02d"
+  instance_count = 20
+  max_parallel   = 10
+  template {
+    cloud_id           = data.hpegl_vmaas_cloud.cloud.id
+    group_id           = data.hpegl_vmaas_group.default_group.id
+    layout_id          = data.hpegl_vmaas_layout.vmware.id
+    plan_id            = data.hpegl_vmaas_plan.g1_small.id
+    instance_type_code = data.hpegl_vmaas_layout.vmware.instance_type_code
+    network {
+      id = data.hpegl_vmaas_network.blue_net.id
+    }
+    volume {
+      name         = "root_vol"
+      size         = 5
+      datastore_id = data.hpegl_vmaas_datastore.c_3par.id
+    }
+    labels = ["worker"]
+    tags = {
+      role = "worker"
+    }
+    config {
+      resource_pool_id = data.hpegl_vmaas_resource_pool.cl_resource_pool.id
+      template_id      = data.hpegl_vmaas_template.vanilla.id
+      no_agent         = false
+      asset_tag        = "vm_tag"
+      folder_code      = data.hpegl_vmaas_cloud_folder.compute_folder.code
+    }
+    environment_code = data.hpegl_vmaas_environment.dev.code
+  }
+}
+
+output "worker_ips" {
+  value = [for m in hpegl_vmaas_instance_group.tf_workers.member : m.ip_addresses]
+}
@@ -0,0 +1,40 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+# reserve the next free IP address of the pool
+resource "hpegl_vmaas_ip_address" "tf_vip" {
+  pool_id  = hpegl_vmaas_network_pool.tf_pool.id
+  hostname = "tf-vip"
+}
+
+# reserve a specific IP address of the pool
+resource "hpegl_vmaas_ip_address" "tf_appliance" {
+  pool_id    = hpegl_vmaas_network_pool.tf_pool.id
+  ip_address = "10.100.0.20"
+  hostname   = "tf-appliance"
+}
+
+# use the reserved IP address as the static IP address of an instance
+resource "hpegl_vmaas_instance" "tf_appliance" {
+  name               = "tf-appliance"
+  cloud_id           = data.hpegl_vmaas_cloud.cloud.id
+  group_id           = data.hpegl_vmaas_group.default_group.id
+  layout_id          = data.hpegl_vmaas_layout.vmware_centos.id
+  plan_id            = data.hpegl_vmaas_plan.g1_small.id
+  instance_type_code = data.hpegl_vmaas_layout.vmware_centos.instance_type_code
+  network {
+    id         = hpegl_vmaas_network.test_net.id
+    ip_address = hpegl_vmaas_ip_address.tf_appliance.ip_address
+  }
+
+  volume {
+    name         = "root_vol"
+    size         = 5
+    datastore_id = data.hpegl_vmaas_datastore.c_3par.id
+  }
+
+  config {
+    resource_pool_id = data.hpegl_vmaas_resource_pool.cl_resource_pool.id
+    folder_code      = data.hpegl_vmaas_cloud_folder.compute_folder.code
+  }
+  environment_code = data.hpegl_vmaas_environment.dev.code
+}
@@ -0,0 +1,29 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_network" "dual_stack_net" {
+  name               = "tf_nsx_t_dual_stack_network"
+  description        = "Dual stack DHCP Network create using tf"
+  scope_id           = data.hpegl_vmaas_transport_zone.tf_zone.provider_id
+  cidr               = "10.100.0.1/24"
+  primary_dns        = "8.8.8.8"
+  cidr_ipv6          = "2001:db8:100::1/64"
+  primary_dns_ipv6   = "2001:4860:4860::8888"
+  secondary_dns_ipv6 = "2001:4860:4860::8844"
+  scan_network       = false
+  active             = true
+  group_id           = "shared"
+  dhcp_enabled       = true
+  connected_gateway  = data.hpegl_vmaas_router.tier1_router.provider_id
+  resource_permissions {
+    all = true
+  }
+  dhcp_network {
+    dhcp_type                = "dhcpLocal"
+    dhcp_server              = data.hpegl_vmaas_dhcp_server.tf_dhcp.provider_id
+    dhcp_lease_time          = "86400"
+    dhcp_range               = "10.100.0.11-10.100.0.250"
+    dhcp_server_address      = "10.100.0.2/24"
+    dhcp_range_ipv6          = "2001:db8:100::11-2001:db8:100::ff"
+    dhcp_server_address_ipv6 = "2001:db8:100::2/64"
+  }
+}
@@ -0,0 +1,13 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_network_domain" "tf_domain" {
+  name        = "tf.example.com"
+  description = "Network domain created using tf"
+  visibility  = "private"
+  domain_join {
+    username  = "administrator"
+    password  = var.domain_password
+    dc_server = "dc01.tf.example.com"
+    ou_path   = "OU=VMs,DC=tf,DC=example,DC=com"
+  }
+}
@@ -0,0 +1,17 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_network_pool" "tf_pool" {
+  name             = "tf_network_pool"
+  gateway          = "10.100.0.1"
+  dns_servers      = ["8.8.8.8", "8.8.4.4"]
+  dns_domain       = "tf.example.com"
+  reverse_dns_zone = "0.100.10.in-addr.arpa"
+  ip_ranges {
+    start_address = "10.100.0.10"
+    end_address   = "10.100.0.50"
+  }
+  ip_ranges {
+    start_address = "10.100.0.100"
+    end_address   = "10.100.0.150"
+  }
+}
@@ -0,0 +1,17 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_nsx_security_group" "tf_web_tier" {
+  name         = "tf_web_tier"
+  description  = "Web tier instances, created via terraform"
+  ip_addresses = ["10.10.0.0/24"]
+  criteria {
+    key      = "Tag"
+    operator = "EQUALS"
+    value    = "tier|web"
+  }
+  criteria {
+    key      = "Name"
+    operator = "STARTSWITH"
+    value    = "web-"
+  }
+}
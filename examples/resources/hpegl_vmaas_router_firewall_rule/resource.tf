@@ -0,0 +1,19 @@
+# (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+resource "hpegl_vmaas_router_firewall_rule" "tf_router_firewall_rule" {
+  name                  = "tf_router_firewall_rule"
+  router_id             = data.hpegl_vmaas_router.tf_router.id
+  group_id              = hpegl_vmaas_router_firewall_rule_group.tf_router_firewall_rule_group.id
+  description           = "Router firewall rule created via terraform"
+  action                = "ALLOW"
+  direction             = "IN_OUT"
+  logging               = true
+  priority              = 10
+  source_addresses      = ["10.10.0.0/24"]
+  destination_addresses = ["10.20.0.10"]
+  services              = ["/infra/services/HTTPS"]
+  ports {
+    protocol          = "TCP"
+    destination_ports = ["8000-8080"]
+  }
+}
@@ -11,3 +11,14 @@ resource "hpegl_vmaas_router_route" "tf_route" {
   mtu           = "65535"
   priority      = 100
 }
+
+resource "hpegl_vmaas_router_route" "tf_route_ipv6" {
+  name          = "tf_route_ipv6"
+  router_id     = 71
+  description   = "IPv6 static route"
+  enabled       = true
+  default_route = false
+  network       = "2001:db8:200::/64"
+  next_hop      = "2001:db8:100::fe"
+  priority      = 100
+}
@@ -0,0 +1,31 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package acceptancetest
+
+import (
+	"testing"
+
+	api_client "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/atf"
+)
+
+func TestAccDataSourceNetworkList(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_networks",
+		GetAPI: func(attr map[string]string) (interface{}, error) {
+			cl, cfg := getAPIClient()
+			iClient := api_client.NetworksAPIService{
+				Client: cl,
+				Cfg:    cfg,
+			}
+			// validate the first network in the list
+			id := toInt(attr["ids.0"])
+
+			return iClient.GetSpecificNetwork(getAccContext(), id)
+		},
+	}
+
+	acc.RunDataSourceTests(t)
+}
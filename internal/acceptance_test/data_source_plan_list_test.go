@@ -0,0 +1,31 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package acceptancetest
+
+import (
+	"testing"
+
+	api_client "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/atf"
+)
+
+func TestAccDataSourcePlanList(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_plans",
+		GetAPI: func(attr map[string]string) (interface{}, error) {
+			cl, cfg := getAPIClient()
+			iClient := api_client.PlansAPIService{
+				Client: cl,
+				Cfg:    cfg,
+			}
+			// validate the first plan in the list
+			id := toInt(attr["ids.0"])
+
+			return iClient.GetSpecificServicePlan(getAccContext(), id)
+		},
+	}
+
+	acc.RunDataSourceTests(t)
+}
@@ -0,0 +1,39 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package acceptancetest
+
+import (
+	"testing"
+
+	api_client "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/atf"
+)
+
+func TestVmaasInstanceGroupPlan(t *testing.T) {
+	acc := &atf.Acc{
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		ResourceName: "hpegl_vmaas_instance_group",
+	}
+	acc.RunResourcePlanTest(t)
+}
+
+func TestAccResourceInstanceGroupCreate(t *testing.T) {
+	acc := &atf.Acc{
+		ResourceName: "hpegl_vmaas_instance_group",
+		PreCheck:     testAccPreCheck,
+		Providers:    testAccProviders,
+		GetAPI: func(attr map[string]string) (interface{}, error) {
+			cl, cfg := getAPIClient()
+			iClient := api_client.InstancesAPIService{
+				Client: cl,
+				Cfg:    cfg,
+			}
+			id := toInt(attr["instance_ids.0"])
+
+			return iClient.GetASpecificInstance(getAccContext(), id)
+		},
+	}
+
+	acc.RunResourceTests(t)
+}
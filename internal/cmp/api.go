@@ -0,0 +1,160 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
+
+	apiClient "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+)
+
+// cmpAPI calls CMP endpoints which are not yet exposed by the cmp-go-sdk.
+// Requests are built the same way as the sdk does, i.e. host, default
+// headers and query params are taken from the sdk configuration and the
+// CMP access token is taken from the sdk client.
+type cmpAPI struct {
+	client *apiClient.APIClient
+	cfg    apiClient.Configuration
+	cache  *cache
+	// pageSize is the max query param used by the list APIs
+	pageSize int
+	// renewToken is true if the token is renewed using the broker, i.e. the
+	// token is not a static Morpheus token
+	renewToken bool
+}
+
+func newCmpAPI(
+	client *apiClient.APIClient,
+	cfg apiClient.Configuration,
+	c *cache,
+	pageSize int,
+	renewToken bool,
+) *cmpAPI {
+	return &cmpAPI{
+		client:     client,
+		cfg:        cfg,
+		cache:      c,
+		pageSize:   pageSize,
+		renewToken: renewToken,
+	}
+}
+
+// getCached calls a read-only CMP GET API and caches the response for
+// catalogueCacheTTL. response is unmarshaled from the cached response body.
+func (c *cmpAPI) getCached(
+	ctx context.Context,
+	path string,
+	queryParams map[string]string,
+	response interface{},
+) error {
+	body, err := cacheGet(ctx, c.cache, cacheKey(path, queryParams), catalogueCacheTTL,
+		func(ctx context.Context) (json.RawMessage, error) {
+			var body json.RawMessage
+			err := c.do(ctx, http.MethodGet, path, queryParams, nil, &body)
+
+			return body, err
+		})
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, response)
+}
+
+// do calls CMP API with the given method and path relative to CMP API base path.
+// request is marshaled as JSON body if not nil and response is unmarshaled into
+// response if not nil. Non 2xx status codes are returned as client.CustomError.
+func (c *cmpAPI) do(
+	ctx context.Context,
+	method, path string,
+	queryParams map[string]string,
+	request, response interface{},
+) error {
+	u, err := url.Parse(fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(c.cfg.Host, "/"),
+		consts.VmaasCmpAPIBasePath, path))
+	if err != nil {
+		return err
+	}
+	query := u.Query()
+	for k, v := range queryParams {
+		query.Add(k, v)
+	}
+	for k, v := range c.cfg.DefaultQueryParams {
+		query.Add(k, v)
+	}
+	u.RawQuery = query.Encode()
+
+	var body io.Reader
+	if request != nil {
+		reqBody, err := json.Marshal(request)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(reqBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", consts.ContentType)
+	if request != nil {
+		req.Header.Set("Content-Type", consts.ContentType)
+	}
+	req.Header.Set("User-Agent", c.cfg.UserAgent)
+	req.Header.Set("Authorization", "Bearer "+c.getToken(ctx))
+	for header, value := range c.cfg.DefaultHeader {
+		if value != "" && value != " " {
+			req.Header.Add(header, value)
+		}
+	}
+
+	httpClient := c.cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return apiClient.ParseError(resp)
+	}
+	if response == nil {
+		return nil
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(respBody, response)
+}
+
+// getToken returns the CMP access token of the sdk client. If the token is
+// renewable and expired, a CMP status call is made first so that sdk renews
+// the token. Static Morpheus tokens do not have a valid expiry, so they are
+// used as is.
+func (c *cmpAPI) getToken(ctx context.Context) string {
+	if c.renewToken && c.client.TokenExpiry/1000 <= time.Now().Unix() {
+		status := apiClient.CmpStatus{Client: c.client, Cfg: c.cfg}
+		// error is ignored here, since the actual API call will report
+		// the authentication failure
+		_, _ = status.GetCmpVersion(ctx)
+	}
+
+	return c.client.CMPToken
+}
@@ -9,11 +9,19 @@ type BrokerClient struct {
 	DSMorpheusDetails DataSource
 }
 
+// BrokerDetails - provider settings which are returned along with the morpheus details
+type BrokerDetails struct {
+	Location  string
+	SpaceName string
+	TenantID  string
+}
+
 // NewBrokerClient - function to create a new broker client
-func NewBrokerClient(client *apiClient.APIClient, cfg apiClient.Configuration) *BrokerClient {
+func NewBrokerClient(client *apiClient.APIClient, cfg apiClient.Configuration, details BrokerDetails) *BrokerClient {
 	return &BrokerClient{
 		DSMorpheusDetails: newMorpheusBroker(
 			&apiClient.BrokerAPIService{Client: client, Cfg: cfg},
+			details,
 		),
 	}
 }
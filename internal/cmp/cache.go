@@ -0,0 +1,216 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+)
+
+// cache holds the responses of read-only CMP catalogue endpoints, such as
+// network services or CMP version. cache is created per provider instance,
+// so all the resources and data sources of a run share it. Concurrent
+// fetches of the same key are de-duplicated, so only one of them calls CMP
+// and the others wait for its result, unless their context is done. Errors
+// are not cached.
+//
+// Keys are the CMP API paths followed by the query params, so that mutations
+// can invalidate all the entries of a path. A nil cache disables caching.
+type cache struct {
+	mu       sync.Mutex
+	entries  map[string]cacheEntry
+	inflight map[string]*cacheCall
+	now      func() time.Time
+}
+
+type cacheEntry struct {
+	value  interface{}
+	expiry time.Time
+}
+
+// cacheCall is an in-flight fetch of a key. done is closed once the fetch
+// is completed.
+type cacheCall struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+func newCache() *cache {
+	return &cache{
+		entries:  make(map[string]cacheEntry),
+		inflight: make(map[string]*cacheCall),
+		now:      time.Now,
+	}
+}
+
+// get returns the cached value of key if it is not expired, otherwise the
+// value is fetched and cached for ttl. ttl less than 1 caches the value
+// until it is invalidated.
+func (c *cache) get(
+	ctx context.Context,
+	key string,
+	ttl time.Duration,
+	fetch func(ctx context.Context) (interface{}, error),
+) (interface{}, error) {
+	if c == nil {
+		return fetch(ctx)
+	}
+
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok && (e.expiry.IsZero() || c.now().Before(e.expiry)) {
+		c.mu.Unlock()
+
+		return e.value, nil
+	}
+	if call, ok := c.inflight[key]; ok {
+		c.mu.Unlock()
+		// wait for the in-flight fetch, unless the context of this call is done
+		select {
+		case <-call.done:
+			return call.value, call.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	call := &cacheCall{done: make(chan struct{})}
+	c.inflight[key] = call
+	c.mu.Unlock()
+
+	call.value, call.err = fetch(ctx)
+	close(call.done)
+
+	c.mu.Lock()
+	// the entry is not stored if it was invalidated while fetching
+	if c.inflight[key] == call {
+		delete(c.inflight, key)
+		if call.err == nil {
+			e := cacheEntry{value: call.value}
+			if ttl > 0 {
+				e.expiry = c.now().Add(ttl)
+			}
+			c.entries[key] = e
+		}
+	}
+	c.mu.Unlock()
+
+	return call.value, call.err
+}
+
+// invalidate removes the entries of the given paths, including the entries
+// of the objects under the paths, e.g. invalidating "networks" removes
+// "networks/12", but not "networks/routers"
+func (c *cache) invalidate(paths ...string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, path := range paths {
+		log.Printf("[DEBUG] Invalidating cached CMP responses of %s", path)
+		for key := range c.entries {
+			if isCacheKeyOf(key, path) {
+				delete(c.entries, key)
+			}
+		}
+		for key := range c.inflight {
+			if isCacheKeyOf(key, path) {
+				delete(c.inflight, key)
+			}
+		}
+	}
+}
+
+func isCacheKeyOf(key, path string) bool {
+	if key == path || strings.HasPrefix(key, path+"?") {
+		return true
+	}
+	if !strings.HasPrefix(key, path+"/") {
+		return false
+	}
+	// sub path is an object only if it starts with the object ID
+	id := strings.TrimPrefix(key, path+"/")
+	if i := strings.IndexAny(id, "/?"); i >= 0 {
+		id = id[:i]
+	}
+	_, err := strconv.Atoi(id)
+
+	return err == nil
+}
+
+// cacheKey returns the key of a CMP API path and its query params
+func cacheKey(path string, params map[string]string) string {
+	if len(params) == 0 {
+		return path
+	}
+	query := make([]string, 0, len(params))
+	for k, v := range params {
+		query = append(query, k+"="+v)
+	}
+	sort.Strings(query)
+
+	return path + "?" + strings.Join(query, "&")
+}
+
+// cacheGet is the typed version of cache.get
+func cacheGet[T any](
+	ctx context.Context,
+	c *cache,
+	key string,
+	ttl time.Duration,
+	fetch func(ctx context.Context) (T, error),
+) (T, error) {
+	value, err := c.get(ctx, key, ttl, func(ctx context.Context) (interface{}, error) {
+		return fetch(ctx)
+	})
+	if err != nil {
+		var zero T
+
+		return zero, err
+	}
+
+	return value.(T), nil
+}
+
+// getNetworkServices returns the network services, which are used by all the
+// NSX resources and data sources to find the network server
+func (c *cache) getNetworkServices(
+	ctx context.Context,
+	rClient *client.RouterAPIService,
+) (models.GetNetworkServicesResp, error) {
+	return cacheGet(ctx, c, networkServicesCacheKey, catalogueCacheTTL,
+		func(ctx context.Context) (models.GetNetworkServicesResp, error) {
+			return rClient.GetNetworkServices(ctx, nil)
+		})
+}
+
+// getNsxNetworkServerID returns the ID of the NSX-T network server, which is
+// the parent of the distributed firewall, the NSX security groups, the load
+// balancers and the DHCP servers
+func (c *cache) getNsxNetworkServerID(ctx context.Context, rClient *client.RouterAPIService) (int, error) {
+	nsxType, err := c.getNsxType(ctx, rClient.Client)
+	if err != nil {
+		return 0, err
+	}
+	networkServices, err := c.getNetworkServices(ctx, rClient)
+	if err != nil {
+		return 0, err
+	}
+	for _, n := range networkServices.NetworkServices {
+		if n.TypeName == nsxType {
+			return n.ID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("could not find the %s network server", nsxType)
+}
@@ -0,0 +1,244 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testCache returns a cache whose current time is returned by now
+func testCache(now *time.Time) *cache {
+	c := newCache()
+	c.now = func() time.Time {
+		return *now
+	}
+
+	return c
+}
+
+// testFetch returns a fetch func which returns the number of calls made
+func testFetch(calls *int) func(ctx context.Context) (interface{}, error) {
+	return func(ctx context.Context) (interface{}, error) {
+		*calls++
+
+		return *calls, nil
+	}
+}
+
+func TestCacheGetExpiry(t *testing.T) {
+	tests := []struct {
+		name      string
+		ttl       time.Duration
+		elapsed   time.Duration
+		wantCalls int
+	}{
+		{
+			name:      "Test case 1: entry within ttl",
+			ttl:       time.Minute,
+			elapsed:   time.Second * 59,
+			wantCalls: 1,
+		},
+		{
+			name:      "Test case 2: expired entry",
+			ttl:       time.Minute,
+			elapsed:   time.Minute,
+			wantCalls: 2,
+		},
+		{
+			name:      "Test case 3: entry without expiry",
+			ttl:       0,
+			elapsed:   time.Hour * 24,
+			wantCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Now()
+			c := testCache(&now)
+			calls := 0
+			if _, err := c.get(context.Background(), "plans", tt.ttl, testFetch(&calls)); err != nil {
+				t.Fatalf("get() error = %v", err)
+			}
+			now = now.Add(tt.elapsed)
+			got, err := c.get(context.Background(), "plans", tt.ttl, testFetch(&calls))
+			if err != nil {
+				t.Fatalf("get() error = %v", err)
+			}
+			if calls != tt.wantCalls || got != tt.wantCalls {
+				t.Errorf("get() = %v with %d fetches, want %d", got, calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCacheGetError(t *testing.T) {
+	c := newCache()
+	fetchErr := errors.New("fetch failed")
+	_, err := c.get(context.Background(), "plans", time.Minute, func(ctx context.Context) (interface{}, error) {
+		return nil, fetchErr
+	})
+	if !errors.Is(err, fetchErr) {
+		t.Fatalf("get() error = %v, want %v", err, fetchErr)
+	}
+	calls := 0
+	if got, err := c.get(context.Background(), "plans", time.Minute, testFetch(&calls)); err != nil || got != 1 {
+		t.Errorf("get() = %v, %v, want the error not to be cached", got, err)
+	}
+}
+
+func TestCacheGetDeduplicate(t *testing.T) {
+	c := newCache()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	calls := 0
+	fetch := func(ctx context.Context) (interface{}, error) {
+		calls++
+		close(started)
+		<-release
+
+		return "value", nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]interface{}, 3)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		results[0], _ = c.get(context.Background(), "plans", time.Minute, fetch)
+	}()
+	<-started
+	for i := 1; i < len(results); i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = c.get(context.Background(), "plans", time.Minute, fetch)
+		}(i)
+	}
+	// waiters are blocked until the in-flight fetch is released
+	time.Sleep(time.Millisecond * 10)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("fetch is called %d times, want 1", calls)
+	}
+	for i, r := range results {
+		if r != "value" {
+			t.Errorf("result %d = %v, want value", i, r)
+		}
+	}
+}
+
+func TestCacheGetWaitCanceled(t *testing.T) {
+	c := newCache()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	go func() {
+		_, _ = c.get(context.Background(), "plans", time.Minute, func(ctx context.Context) (interface{}, error) {
+			close(started)
+			<-release
+
+			return "value", nil
+		})
+	}()
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	calls := 0
+	_, err := c.get(ctx, "plans", time.Minute, testFetch(&calls))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("get() error = %v, want %v", err, context.Canceled)
+	}
+	if calls != 0 {
+		t.Errorf("fetch is called %d times while in-flight, want 0", calls)
+	}
+}
+
+func TestIsCacheKeyOf(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+		path string
+		want bool
+	}{
+		{
+			name: "Test case 1: same path",
+			key:  "networks",
+			path: "networks",
+			want: true,
+		},
+		{
+			name: "Test case 2: path with query params",
+			key:  "networks?max=100",
+			path: "networks",
+			want: true,
+		},
+		{
+			name: "Test case 3: object of path",
+			key:  "networks/12",
+			path: "networks",
+			want: true,
+		},
+		{
+			name: "Test case 4: sub path of object",
+			key:  "networks/12/pools?max=100",
+			path: "networks",
+			want: true,
+		},
+		{
+			name: "Test case 5: other path under path",
+			key:  "networks/routers",
+			path: "networks",
+			want: false,
+		},
+		{
+			name: "Test case 6: path with same prefix",
+			key:  "networkServices",
+			path: "networks",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isCacheKeyOf(tt.key, tt.path); got != tt.want {
+				t.Errorf("isCacheKeyOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCacheInvalidate(t *testing.T) {
+	c := newCache()
+	keys := []string{"networks", "networks/12", "networks/routers"}
+	calls := 0
+	for _, key := range keys {
+		if _, err := c.get(context.Background(), key, 0, testFetch(&calls)); err != nil {
+			t.Fatalf("get() error = %v", err)
+		}
+	}
+	c.invalidate("networks")
+
+	for key, want := range map[string]bool{"networks": false, "networks/12": false, "networks/routers": true} {
+		if _, ok := c.entries[key]; ok != want {
+			t.Errorf("entry %s is cached = %v, want %v", key, ok, want)
+		}
+	}
+}
+
+func TestNilCache(t *testing.T) {
+	var c *cache
+	calls := 0
+	for i := 1; i <= 2; i++ {
+		got, err := c.get(context.Background(), "plans", 0, testFetch(&calls))
+		if err != nil || got != i {
+			t.Errorf("get() = %v, %v, want %d", got, err, i)
+		}
+	}
+	c.invalidate("plans")
+}
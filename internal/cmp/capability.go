@@ -0,0 +1,122 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+)
+
+// Capability is a CMP feature, such as an endpoint, a field or a naming
+// convention, which is available only from a CMP version
+type Capability struct {
+	Name       string
+	MinVersion string
+}
+
+// CMP capabilities. Versions of the endpoints are the same as the compatible
+// versions of cmp-go-sdk, which fails the API calls on older CMP versions.
+var (
+	// CapRouterAPI is the router, NAT and firewall rule group APIs
+	CapRouterAPI = Capability{Name: "router APIs", MinVersion: "5.2.10"}
+	// CapRoutingAPI is the router route and BGP neighbor APIs
+	CapRoutingAPI = Capability{Name: "router route and BGP neighbor APIs", MinVersion: "5.2.12"}
+	// CapInstanceLabels is the labels field of instances, earlier versions
+	// used tags for labels and metadata for tags
+	CapInstanceLabels = Capability{Name: "instance labels", MinVersion: "5.2.12"}
+	// CapNsxNetworkAPI is the NSX network create and update, DHCP server,
+	// transport zone and edge cluster APIs
+	CapNsxNetworkAPI = Capability{Name: "NSX network and DHCP server APIs", MinVersion: "5.2.13"}
+	// CapNetworkPoolObject is the networkPool object of the network create
+	// request, earlier versions used pool ID
+	CapNetworkPoolObject = Capability{Name: "network pool object of networks", MinVersion: "5.4.4"}
+	// CapResourcePoolPrefix is the pool- prefix of the resource pool ID of
+	// the instance create request
+	CapResourcePoolPrefix = Capability{Name: "prefixed resource pool IDs", MinVersion: "6.0.3"}
+	// CapNsxNaming is the display name of NSX-T integration, which is NSX
+	// from 6.2.4 onwards
+	CapNsxNaming = Capability{Name: "NSX naming", MinVersion: "6.2.4"}
+)
+
+// Capabilities records the capabilities supported by the CMP appliance of a
+// client. Capabilities are computed once per client, see Client.Capabilities.
+type Capabilities struct {
+	version int
+}
+
+func newCapabilities(version int) *Capabilities {
+	return &Capabilities{version: version}
+}
+
+// Version returns the CMP version, e.g. 6.2.4
+func (c *Capabilities) Version() string {
+	return formatVersion(c.version)
+}
+
+// Supports checks CMP supports the capability. All the capabilities are
+// assumed to be supported if the CMP version is not known.
+func (c *Capabilities) Supports(capability Capability) bool {
+	minVersion, err := ParseVersion(capability.MinVersion)
+	if err != nil {
+		panic(fmt.Sprintf("invalid version %s of capability %s", capability.MinVersion, capability.Name))
+	}
+
+	return c.version == 0 || c.version >= minVersion
+}
+
+// Require returns an error if CMP does not support the capability. usedBy
+// is the resource or attribute which requires the capability.
+func (c *Capabilities) Require(capability Capability, usedBy string) error {
+	if c.Supports(capability) {
+		return nil
+	}
+
+	return fmt.Errorf("%s requires CMP >= %s for %s, but the CMP version is %s",
+		usedBy, capability.MinVersion, capability.Name, c.Version())
+}
+
+// nsxType returns the display name of NSX-T network server
+func (c *Capabilities) nsxType() string {
+	if c.Supports(CapNsxNaming) {
+		return nsx
+	}
+
+	return nsxt
+}
+
+// formatVersion formats the version as parsed by ParseVersion
+func formatVersion(version int) string {
+	parts := make([]string, 0, 3)
+	for mul := 10000; mul > 0; mul /= 100 {
+		parts = append(parts, fmt.Sprint(version/mul))
+		version %= mul
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// getCapabilities returns the capabilities of CMP. Capabilities are cached
+// without expiry, since CMP version does not change during a run.
+func (c *cache) getCapabilities(ctx context.Context, apiClient client.APIClientHandler) (*Capabilities, error) {
+	return cacheGet(ctx, c, capabilitiesCacheKey, 0,
+		func(ctx context.Context) (*Capabilities, error) {
+			return fetchCapabilities(ctx, apiClient)
+		})
+}
+
+// fetchCapabilities returns the capabilities of CMP without caching
+func fetchCapabilities(ctx context.Context, apiClient client.APIClientHandler) (*Capabilities, error) {
+	// version is already fetched by the sdk client while configuring the provider
+	if sdkClient, ok := apiClient.(*client.APIClient); ok && sdkClient.GetSCMVersion() != 0 {
+		return newCapabilities(sdkClient.GetSCMVersion()), nil
+	}
+	version, err := GetCmpVersion(ctx, apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCapabilities(version), nil
+}
@@ -0,0 +1,94 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import "testing"
+
+func TestFormatVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		want    string
+	}{
+		{
+			name:    "Test case 1: version with patch",
+			version: "6.2.4",
+			want:    "6.2.4",
+		},
+		{
+			name:    "Test case 2: two digit patch",
+			version: "5.2.13",
+			want:    "5.2.13",
+		},
+		{
+			name:    "Test case 3: version without patch",
+			version: "7.0",
+			want:    "7.0.0",
+		},
+		{
+			name:    "Test case 4: version with suffix",
+			version: "6.0.2-1",
+			want:    "6.0.2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, err := ParseVersion(tt.version)
+			if err != nil {
+				t.Fatalf("ParseVersion() error = %v", err)
+			}
+			if got := formatVersion(version); got != tt.want {
+				t.Errorf("formatVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCapabilitiesSupports(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    string
+		capability Capability
+		want       bool
+	}{
+		{
+			name:       "Test case 1: unknown version",
+			version:    "",
+			capability: CapNsxNaming,
+			want:       true,
+		},
+		{
+			name:       "Test case 2: older version",
+			version:    "6.2.3",
+			capability: CapNsxNaming,
+			want:       false,
+		},
+		{
+			name:       "Test case 3: same version",
+			version:    "6.2.4",
+			capability: CapNsxNaming,
+			want:       true,
+		},
+		{
+			name:       "Test case 4: newer minor version with lower patch",
+			version:    "5.3.1",
+			capability: CapNsxNetworkAPI,
+			want:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, err := ParseVersion(tt.version)
+			if err != nil {
+				t.Fatalf("ParseVersion() error = %v", err)
+			}
+			c := newCapabilities(version)
+			if got := c.Supports(tt.capability); got != tt.want {
+				t.Errorf("Supports() = %v, want %v", got, tt.want)
+			}
+			if err := c.Require(tt.capability, "test"); (err == nil) != tt.want {
+				t.Errorf("Require() error = %v, want supported %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,444 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+)
+
+// catalogue describes a kind of catalogue object, such as network or plan,
+// and how it is fetched from CMP. catalogue is shared by the lookup and the
+// plural data sources of the object.
+type catalogue[T any] struct {
+	api *cmpAPI
+	// kind is the name of the object used in the error messages
+	kind string
+	// path returns the CMP API path of the list
+	path func(d *utils.Data) string
+	// pathContext is used instead of path if the path depends on other CMP
+	// objects, such as the NSX network server of edge clusters
+	pathContext func(ctx context.Context, d *utils.Data) (string, error)
+	// listKey and itemKey are the keys of the object in list and get responses.
+	// itemKey is empty if CMP does not support getting a single object, then
+	// the list is filtered by id instead.
+	listKey string
+	itemKey string
+	// nameQuery denotes CMP supports filtering the list by exact name
+	nameQuery bool
+	// queryFilters maps the filter names to the query params supported by CMP
+	queryFilters map[string]string
+	// item returns ID, name and attributes of the object
+	item func(item T) (int, string, map[string]interface{})
+	// dateCreated returns the creation date of the object, used by most_recent
+	dateCreated func(item T) string
+}
+
+// listPath returns the CMP API path of the list
+func (c catalogue[T]) listPath(ctx context.Context, d *utils.Data) (string, error) {
+	if c.pathContext != nil {
+		return c.pathContext(ctx, d)
+	}
+
+	return c.path(d), nil
+}
+
+// get fetches a single object using its ID
+func (c catalogue[T]) get(ctx context.Context, path string, id int) (T, error) {
+	var item T
+	var resp map[string]json.RawMessage
+	if err := c.api.getCached(ctx, fmt.Sprintf("%s/%d", path, id), nil, &resp); err != nil {
+		return item, err
+	}
+	raw, ok := resp[c.itemKey]
+	if !ok {
+		return item, fmt.Errorf(errExactMatch, c.kind)
+	}
+
+	return item, json.Unmarshal(raw, &item)
+}
+
+// supportedQueryFilters returns the filters which can be sent to CMP. phrase
+// is supported by all the CMP list APIs.
+func (c catalogue[T]) supportedQueryFilters() map[string]string {
+	queryFilters := map[string]string{phraseFilter: phraseKey}
+	for k, v := range c.queryFilters {
+		queryFilters[k] = v
+	}
+
+	return queryFilters
+}
+
+// queryParams returns the query params for the filters supported by CMP
+func (c catalogue[T]) queryParams(f *lookupFilter) map[string]string {
+	return f.queryParams(c.supportedQueryFilters())
+}
+
+// candidates returns the objects matching the filter
+func (c catalogue[T]) candidates(f *lookupFilter, items []T) ([]lookupCandidate, error) {
+	var zero T
+	_, _, attributes := c.item(zero)
+	if err := f.validate(attributes, c.supportedQueryFilters()); err != nil {
+		return nil, err
+	}
+
+	candidates := make([]lookupCandidate, 0, len(items))
+	for _, item := range items {
+		id, name, attributes := c.item(item)
+		candidate := lookupCandidate{
+			id:         id,
+			name:       name,
+			attributes: attributes,
+			item:       item,
+		}
+		if c.dateCreated != nil {
+			candidate.dateCreated = c.dateCreated(item)
+		}
+		if f.match(candidate) {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	return candidates, nil
+}
+
+// nsxServerPath returns the pathContext of the catalogue objects which belong
+// to the NSX network server, such as edge clusters
+func nsxServerPath(api *cmpAPI, path string) func(ctx context.Context, d *utils.Data) (string, error) {
+	return func(ctx context.Context, d *utils.Data) (string, error) {
+		serverID, err := api.cache.getNsxNetworkServerID(ctx,
+			&client.RouterAPIService{Client: api.client, Cfg: api.cfg})
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("networks/servers/%d/%s", serverID, path), nil
+	}
+}
+
+// filterItems returns the items satisfying the client side filter of a data
+// source. All the items are returned if the data source has no such filter.
+func filterItems[T any](
+	ctx context.Context,
+	d *utils.Data,
+	items []T,
+	filter func(ctx context.Context, d *utils.Data) (func(item T) bool, error),
+) ([]T, error) {
+	if filter == nil {
+		return items, nil
+	}
+	match, err := filter(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]T, 0, len(items))
+	for _, item := range items {
+		if match(item) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	return filtered, nil
+}
+
+// Catalogue models are used by lookup data sources where the cmp-go-sdk
+// models are missing attributes returned by CMP.
+
+type cmpIDName struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Code string `json:"code"`
+}
+
+type cmpNetworks struct {
+	Networks []cmpNetwork `json:"networks"`
+}
+
+type cmpNetwork struct {
+	ID            int       `json:"id"`
+	Name          string    `json:"name"`
+	DisplayName   string    `json:"displayName"`
+	Description   string    `json:"description"`
+	ExternalID    string    `json:"externalId"`
+	Cidr          string    `json:"cidr"`
+	Gateway       string    `json:"gateway"`
+	DNSPrimary    string    `json:"dnsPrimary"`
+	DNSSecondary  string    `json:"dnsSecondary"`
+	VlanID        int       `json:"vlanId"`
+	DhcpServer    bool      `json:"dhcpServer"`
+	Active        bool      `json:"active"`
+	Status        string    `json:"status"`
+	Visibility    string    `json:"visibility"`
+	DateCreated   string    `json:"dateCreated"`
+	Type          cmpIDName `json:"type"`
+	Zone          cmpIDName `json:"zone"`
+	Pool          cmpIDName `json:"pool"`
+	NetworkDomain cmpIDName `json:"networkDomain"`
+}
+
+type cmpVirtualImages struct {
+	VirtualImages []cmpVirtualImage `json:"virtualImages"`
+}
+
+type cmpVirtualImage struct {
+	ID          int            `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	ExternalID  string         `json:"externalId"`
+	ImageType   string         `json:"imageType"`
+	Status      string         `json:"status"`
+	IsCloudInit bool           `json:"isCloudInit"`
+	MinRAM      int64          `json:"minRam"`
+	MinDisk     int64          `json:"minDisk"`
+	RawSize     int64          `json:"rawSize"`
+	DateCreated string         `json:"dateCreated"`
+	OsType      cmpVirtualOS   `json:"osType"`
+	Tags        []cmpNameValue `json:"tags"`
+}
+
+type cmpVirtualOS struct {
+	ID        int    `json:"id"`
+	Code      string `json:"code"`
+	Name      string `json:"name"`
+	Category  string `json:"category"`
+	OsFamily  string `json:"osFamily"`
+	OsVersion string `json:"osVersion"`
+	BitCount  int    `json:"bitCount"`
+	Platform  string `json:"platform"`
+}
+
+type cmpNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// tagsMap converts the tags of a catalogue object to a map attribute
+func tagsMap(tags []cmpNameValue) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		m[tag.Name] = tag.Value
+	}
+
+	return m
+}
+
+// hasTags checks the object has all the tags. A tag with an empty value
+// matches any value of the tag.
+func hasTags(tags []cmpNameValue, required map[string]interface{}) bool {
+	for name, value := range required {
+		found := false
+		for _, tag := range tags {
+			if tag.Name == name && (value == "" || tag.Value == value) {
+				found = true
+
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+type cmpDatastores struct {
+	Datastores []cmpDatastore `json:"datastores"`
+}
+
+type cmpDatastore struct {
+	ID          int            `json:"id"`
+	Name        string         `json:"name"`
+	Type        string         `json:"type"`
+	ExternalID  string         `json:"externalId"`
+	StorageSize int64          `json:"storageSize"`
+	FreeSpace   int64          `json:"freeSpace"`
+	Online      bool           `json:"online"`
+	Active      bool           `json:"active"`
+	Visibility  string         `json:"visibility"`
+	DateCreated string         `json:"dateCreated"`
+	Zone        cmpIDName      `json:"zone"`
+	Tags        []cmpNameValue `json:"tags"`
+}
+
+type cmpLayout struct {
+	ID                int       `json:"id"`
+	Name              string    `json:"name"`
+	Code              string    `json:"code"`
+	InstanceVersion   string    `json:"instanceVersion"`
+	MemoryRequirement int       `json:"memoryRequirement"`
+	SortOrder         int       `json:"sortOrder"`
+	Creatable         bool      `json:"creatable"`
+	DateCreated       string    `json:"dateCreated"`
+	InstanceType      cmpIDName `json:"instanceType"`
+	ProvisionType     cmpIDName `json:"provisionType"`
+}
+
+// setAttributes sets all the computed attributes of a lookup data source
+func setAttributes(d *utils.Data, attributes map[string]interface{}) {
+	for k, v := range attributes {
+		d.Set(k, v)
+	}
+}
+
+func networkAttributes(n cmpNetwork) map[string]interface{} {
+	return map[string]interface{}{
+		"display_name":  n.DisplayName,
+		"description":   n.Description,
+		"external_id":   n.ExternalID,
+		"cidr":          n.Cidr,
+		"gateway":       n.Gateway,
+		"primary_dns":   n.DNSPrimary,
+		"secondary_dns": n.DNSSecondary,
+		"vlan_id":       n.VlanID,
+		"dhcp_server":   n.DhcpServer,
+		"active":        n.Active,
+		"status":        n.Status,
+		"visibility":    n.Visibility,
+		"type_code":     n.Type.Code,
+		"cloud_id":      n.Zone.ID,
+		"pool_id":       n.Pool.ID,
+		"pool_name":     n.Pool.Name,
+		"domain_id":     n.NetworkDomain.ID,
+	}
+}
+
+func planAttributes(p models.ServicePlanResponse) map[string]interface{} {
+	return map[string]interface{}{
+		"code":             p.Code,
+		"active":           p.Active,
+		"provision_type":   p.ProvisionType.Code,
+		"max_cores":        p.MaxCores,
+		"cores_per_socket": p.CoresPerSocket,
+		"max_memory":       int(p.MaxMemory),
+		"max_storage":      int(p.MaxStorage),
+		"max_disks":        p.MaxDisks,
+		"sort_order":       p.SortOrder,
+
+		"custom_cpu":               p.CustomCPU,
+		"custom_cores":             p.CustomCores,
+		"custom_max_memory":        p.CustomMaxMemory,
+		"custom_max_storage":       p.CustomMaxStorage,
+		"custom_max_data_storage":  p.CustomMaxDataStorage,
+		"add_volumes":              p.AddVolumes,
+		"root_disk_customizable":   p.ProvisionType.RootDiskCustomizable,
+		"configurable_cpu_sockets": p.ProvisionType.HasConfigurableCPUSockets,
+	}
+}
+
+func templateAttributes(t cmpVirtualImage) map[string]interface{} {
+	return map[string]interface{}{
+		"description":   t.Description,
+		"external_id":   t.ExternalID,
+		"image_type":    t.ImageType,
+		"status":        t.Status,
+		"is_cloud_init": t.IsCloudInit,
+		"min_memory":    int(t.MinRAM),
+		"min_disk":      int(t.MinDisk),
+		"size":          int(t.RawSize),
+		"date_created":  t.DateCreated,
+		"os_type":       t.OsType.Code,
+		"os_name":       t.OsType.Name,
+		"os_family":     t.OsType.OsFamily,
+		"os_version":    t.OsType.OsVersion,
+		"os_platform":   t.OsType.Platform,
+		"os_bit_count":  t.OsType.BitCount,
+		"tags":          tagsMap(t.Tags),
+	}
+}
+
+func datastoreAttributes(ds cmpDatastore) map[string]interface{} {
+	return map[string]interface{}{
+		"type":        ds.Type,
+		"external_id": ds.ExternalID,
+		"capacity":    int(ds.StorageSize),
+		"free_space":  int(ds.FreeSpace),
+		"online":      ds.Online,
+		"active":      ds.Active,
+		"visibility":  ds.Visibility,
+		"tags":        tagsMap(ds.Tags),
+	}
+}
+
+func cloudAttributes(c models.CloudRespBody) map[string]interface{} {
+	return map[string]interface{}{
+		"code":        c.Code,
+		"location":    c.Location,
+		"type_code":   c.Zonetype.Code,
+		"status":      c.Status,
+		"enabled":     c.Enabled,
+		"region_code": c.Regioncode,
+		"visibility":  c.Visibility,
+	}
+}
+
+func groupAttributes(g models.Group) map[string]interface{} {
+	cloudIDs := make([]int, 0, len(g.Zones))
+	for _, z := range g.Zones {
+		cloudIDs = append(cloudIDs, z.ID)
+	}
+
+	return map[string]interface{}{
+		"code":      g.Code,
+		"location":  g.Location,
+		"active":    g.Active,
+		"cloud_ids": cloudIDs,
+	}
+}
+
+func powerScheduleAttributes(p models.GetAllPowerSchedulesSchedules) map[string]interface{} {
+	description, _ := p.Description.(string)
+
+	return map[string]interface{}{
+		"description":       description,
+		"enabled":           p.Enabled,
+		"schedule_type":     p.Scheduletype,
+		"schedule_timezone": p.Scheduletimezone,
+	}
+}
+
+func routerAttributes(r models.GetNetworkRouter) map[string]interface{} {
+	return map[string]interface{}{
+		"code":        r.Code,
+		"router_type": r.RouterType,
+		"provider_id": r.ProviderID,
+		"external_id": r.ExternalID,
+		"status":      r.Status,
+		"enabled":     r.Enabled,
+		"cloud_id":    r.Zone.ID,
+		"interfaces":  routerInterfaces(r.Interfaces),
+	}
+}
+
+func routerInterfaces(interfaces []models.RouterInterfaces) []map[string]interface{} {
+	list := make([]map[string]interface{}, 0, len(interfaces))
+	for _, i := range interfaces {
+		list = append(list, map[string]interface{}{
+			"id":               i.ID,
+			"source_addresses": i.IPAddress,
+			"cidr":             i.Cidr,
+		})
+	}
+
+	return list
+}
+
+func loadBalancerAttributes(lb models.GetNetworkLoadBalancerResp) map[string]interface{} {
+	return map[string]interface{}{
+		"description": lb.Description,
+		"type_code":   lb.Type.Code,
+		"host":        lb.Host,
+		"ip":          lb.IP,
+		"port":        lb.Port,
+		"ssl_enabled": lb.SSLEnabled,
+		"enabled":     lb.Enabled,
+		"visibility":  lb.Visibility,
+		"cloud_id":    lb.Cloud.ID,
+	}
+}
@@ -0,0 +1,233 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"encoding/json"
+	"hash/fnv"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+)
+
+// cmpListMeta is the pagination details returned by CMP list APIs
+type cmpListMeta struct {
+	Size   int `json:"size"`
+	Total  int `json:"total"`
+	Offset int `json:"offset"`
+	Max    int `json:"max"`
+}
+
+// catalogueList implements plural data sources, which list all the catalogue
+// objects of a kind along with their attributes
+type catalogueList[T any] struct {
+	catalogue[T]
+	// attribute is the name of list attribute in the data source
+	attribute string
+	// params returns the query params for the arguments which are supported by CMP
+	params func(d *utils.Data) map[string]string
+	// filter returns the client side filter for the arguments which are
+	// not supported by CMP
+	filter func(ctx context.Context, d *utils.Data) (func(item T) bool, error)
+}
+
+func (c *catalogueList[T]) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, c.api.client)
+	log.Printf("[DEBUG] List %s", c.attribute)
+
+	f, err := getLookupFilter(d, false)
+	if err != nil {
+		return err
+	}
+	params := c.queryParams(f)
+	if c.params != nil {
+		addDefaultParams(params, c.params(d))
+	}
+	if phrase := d.GetString("phrase"); phrase != "" {
+		params[phraseKey] = phrase
+	}
+	// Pre check
+	if err := d.Error(); err != nil {
+		return err
+	}
+	path, err := c.listPath(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	items, err := listCatalogue[T](ctx, c.api, path, c.listKey, params)
+	if err != nil {
+		return err
+	}
+	if items, err = filterItems(ctx, d, items, c.filter); err != nil {
+		return err
+	}
+	candidates, err := c.candidates(f, items)
+	if err != nil {
+		return err
+	}
+
+	list := make([]map[string]interface{}, 0, len(candidates))
+	ids := make([]int, 0, len(candidates))
+	for _, candidate := range candidates {
+		candidate.attributes["id"] = candidate.id
+		candidate.attributes["name"] = candidate.name
+		list = append(list, candidate.attributes)
+		ids = append(ids, candidate.id)
+	}
+
+	d.Set(c.attribute, list)
+	d.Set("ids", ids)
+	d.SetID(catalogueListID(path, params, ids))
+
+	// post check
+	return d.Error()
+}
+
+// catalogueLookup implements lookup data sources, which return a single
+// catalogue object matching the id, name, name_regex and filter arguments
+type catalogueLookup[T any] struct {
+	catalogue[T]
+	// params returns the query params for the arguments which are supported by CMP
+	params func(d *utils.Data) map[string]string
+	// filter returns the client side filter for the arguments which are
+	// not supported by CMP
+	filter func(ctx context.Context, d *utils.Data) (func(item T) bool, error)
+	// order returns the less function used to select the first of the
+	// matching objects, such as the smallest plan. If it returns nil, more
+	// than one match is an error unless most_recent is set.
+	order func(d *utils.Data) func(a, b T) bool
+}
+
+func (c *catalogueLookup[T]) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, c.api.client)
+	log.Printf("[DEBUG] Get %s", c.kind)
+
+	f, err := getLookupFilter(d, true)
+	if err != nil {
+		return err
+	}
+	// Pre check
+	if err := d.Error(); err != nil {
+		return err
+	}
+	path, err := c.listPath(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	var items []T
+	if f.id != 0 && c.itemKey != "" {
+		item, err := c.get(ctx, path, f.id)
+		if err != nil {
+			return err
+		}
+		items = []T{item}
+	} else {
+		params := c.queryParams(f)
+		if c.params != nil {
+			addDefaultParams(params, c.params(d))
+		}
+		if c.nameQuery && f.name != "" {
+			params[nameKey] = f.name
+		}
+		if items, err = listCatalogue[T](ctx, c.api, path, c.listKey, params); err != nil {
+			return err
+		}
+	}
+
+	if items, err = filterItems(ctx, d, items, c.filter); err != nil {
+		return err
+	}
+	candidates, err := c.candidates(f, items)
+	if err != nil {
+		return err
+	}
+	if c.order != nil {
+		if less := c.order(d); less != nil && len(candidates) > 1 {
+			sort.SliceStable(candidates, func(i, j int) bool {
+				return less(candidates[i].item.(T), candidates[j].item.(T))
+			})
+			candidates = candidates[:1]
+		}
+	}
+	i, err := f.selectCandidate(c.kind, candidates)
+	if err != nil {
+		return err
+	}
+	setAttributes(d, candidates[i].attributes)
+	d.SetString("name", candidates[i].name)
+	d.SetID(candidates[i].id)
+
+	// post check
+	return d.Error()
+}
+
+// addDefaultParams adds the query params of the arguments to the query params
+// of the filters. Explicit filters take precedence over the arguments, such
+// as the default provision type of plans.
+func addDefaultParams(params, defaults map[string]string) {
+	for k, v := range defaults {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+}
+
+// listCatalogue fetches all the pages of a CMP list API. listKey is the
+// key of the list in CMP response.
+func listCatalogue[T any](
+	ctx context.Context,
+	api *cmpAPI,
+	path, listKey string,
+	params map[string]string,
+) ([]T, error) {
+	return listAll(ctx, api.pageSize, params, catalogueFetcher[T](api, path, listKey))
+}
+
+// catalogueFetcher returns the pageFetcher of a CMP list API, which is not
+// exposed by the cmp-go-sdk. Pages are cached, see cmpAPI.getCached.
+func catalogueFetcher[T any](api *cmpAPI, path, listKey string) pageFetcher[T] {
+	return func(ctx context.Context, params map[string]string) ([]T, int, error) {
+		var resp map[string]json.RawMessage
+		if err := api.getCached(ctx, path, params, &resp); err != nil {
+			return nil, 0, err
+		}
+
+		var page []T
+		if raw, ok := resp[listKey]; ok {
+			if err := json.Unmarshal(raw, &page); err != nil {
+				return nil, 0, err
+			}
+		}
+		var pageMeta cmpListMeta
+		if raw, ok := resp["meta"]; ok {
+			if err := json.Unmarshal(raw, &pageMeta); err != nil {
+				return nil, 0, err
+			}
+		}
+
+		return page, pageMeta.Total, nil
+	}
+}
+
+// catalogueListID returns a stable ID for the plural data sources
+func catalogueListID(path string, params map[string]string, ids []int) string {
+	h := fnv.New32a()
+	h.Write([]byte(path))
+	keys := make([]string, 0, len(params))
+	for k, v := range params {
+		keys = append(keys, k+"="+v)
+	}
+	sort.Strings(keys)
+	h.Write([]byte(strings.Join(keys, "&")))
+	for _, id := range ids {
+		h.Write([]byte("," + strconv.Itoa(id)))
+	}
+
+	return strconv.FormatUint(uint64(h.Sum32()), 10)
+}
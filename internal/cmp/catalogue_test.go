@@ -0,0 +1,229 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testLookupSchema returns the lookup schema with the string, int and bool
+// attributes of the catalogue
+func testLookupSchema(attributes map[string]interface{}) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"id":          {Type: schema.TypeString, Optional: true, Computed: true},
+		"name":        {Type: schema.TypeString, Optional: true, Computed: true},
+		"name_regex":  {Type: schema.TypeString, Optional: true},
+		"most_recent": {Type: schema.TypeBool, Optional: true},
+		"filter": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name":   {Type: schema.TypeString, Required: true},
+					"values": {Type: schema.TypeList, Required: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				},
+			},
+		},
+	}
+	for k, v := range attributes {
+		switch v.(type) {
+		case int:
+			s[k] = &schema.Schema{Type: schema.TypeInt, Computed: true}
+		case bool:
+			s[k] = &schema.Schema{Type: schema.TypeBool, Computed: true}
+		default:
+			s[k] = &schema.Schema{Type: schema.TypeString, Computed: true}
+		}
+	}
+
+	return s
+}
+
+func TestCatalogueLookupWithoutGet(t *testing.T) {
+	tests := []struct {
+		name           string
+		config         map[string]interface{}
+		wantID         string
+		wantProviderID string
+		wantErr        bool
+	}{
+		{
+			name:           "Test case 1: lookup by id filters the list",
+			config:         map[string]interface{}{"id": "2"},
+			wantID:         "2",
+			wantProviderID: "ec-2",
+		},
+		{
+			name:           "Test case 2: lookup by name",
+			config:         map[string]interface{}{"name": "edge-1"},
+			wantID:         "1",
+			wantProviderID: "ec-1",
+		},
+		{
+			name:    "Test case 3: id is not in the list",
+			config:  map[string]interface{}{"id": "3"},
+			wantErr: true,
+		},
+		{
+			name:    "Test case 4: more than one match",
+			config:  map[string]interface{}{"name_regex": "^edge-"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var paths []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				paths = append(paths, r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"networkEdgeClusters":[` +
+					`{"id":1,"name":"edge-1","providerId":"ec-1"},` +
+					`{"id":2,"name":"edge-2","providerId":"ec-2"}]}`))
+			}))
+			defer server.Close()
+
+			c := newEdgeCluster(testCmpAPI(server))
+			c.pathContext = func(ctx context.Context, d *utils.Data) (string, error) {
+				return "networks/servers/4/edge-clusters", nil
+			}
+			_, _, attributes := c.item(models.NetworkEdgeClusters{})
+			d := utils.NewData(schema.TestResourceDataRaw(t, testLookupSchema(attributes), tt.config))
+			err := c.Read(context.Background(), d, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Read() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for _, p := range paths {
+				if !strings.HasSuffix(p, "/networks/servers/4/edge-clusters") {
+					t.Errorf("unexpected request to %s", p)
+				}
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := d.Id(); got != tt.wantID {
+				t.Errorf("id = %s, want %s", got, tt.wantID)
+			}
+			if got := d.GetString("provider_id"); got != tt.wantProviderID {
+				t.Errorf("provider_id = %s, want %s", got, tt.wantProviderID)
+			}
+		})
+	}
+}
+
+func TestRouterInterfaces(t *testing.T) {
+	got := routerInterfaces([]models.RouterInterfaces{{ID: 1, IPAddress: "10.0.0.1", Cidr: "10.0.0.0/24"}})
+	want := []map[string]interface{}{{"id": 1, "source_addresses": "10.0.0.1", "cidr": "10.0.0.0/24"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("routerInterfaces() = %v, want %v", got, want)
+	}
+}
+
+// testListSchema returns the plural data source schema with the attributes of
+// the catalogue in the list attribute
+func testListSchema(attribute string, attributes map[string]interface{}) map[string]*schema.Schema {
+	s := testLookupSchema(nil)
+	itemSchema := map[string]*schema.Schema{
+		"id":   {Type: schema.TypeInt, Computed: true},
+		"name": {Type: schema.TypeString, Computed: true},
+	}
+	for k, v := range testLookupSchema(attributes) {
+		if _, ok := attributes[k]; ok {
+			itemSchema[k] = v
+		}
+	}
+	for k, v := range attributes {
+		if _, ok := v.([]map[string]interface{}); ok {
+			itemSchema[k] = &schema.Schema{Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeMap}}
+		}
+	}
+	s["phrase"] = &schema.Schema{Type: schema.TypeString, Optional: true}
+	s["cloud_id"] = &schema.Schema{Type: schema.TypeInt, Optional: true}
+	s["ids"] = &schema.Schema{Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeInt}}
+	s[attribute] = &schema.Schema{Type: schema.TypeList, Computed: true, Elem: &schema.Resource{Schema: itemSchema}}
+
+	return s
+}
+
+func TestCatalogueListRoutersAndLoadBalancers(t *testing.T) {
+	tests := []struct {
+		name       string
+		list       func(api *cmpAPI) (DataSource, map[string]interface{})
+		attribute  string
+		response   string
+		config     map[string]interface{}
+		wantIDs    []int
+		wantPhrase string
+	}{
+		{
+			name: "Test case 1: routers of the cloud matching the filter",
+			list: func(api *cmpAPI) (DataSource, map[string]interface{}) {
+				l := newRouterList(api)
+				_, _, attributes := l.item(models.GetNetworkRouter{})
+
+				return l, attributes
+			},
+			attribute: "routers",
+			response: `{"networkRouters":[` +
+				`{"id":1,"name":"t1-a","status":"ok","zone":{"id":1}},` +
+				`{"id":2,"name":"t1-b","status":"ok","zone":{"id":2}},` +
+				`{"id":3,"name":"t1-c","status":"error","zone":{"id":1}}],"meta":{"total":3}}`,
+			config: map[string]interface{}{
+				"phrase":   "t1",
+				"cloud_id": 1,
+				"filter":   []interface{}{map[string]interface{}{"name": "status", "values": []interface{}{"ok"}}},
+			},
+			wantIDs:    []int{1},
+			wantPhrase: "t1",
+		},
+		{
+			name: "Test case 2: load balancers matching the name regex",
+			list: func(api *cmpAPI) (DataSource, map[string]interface{}) {
+				l := newLoadBalancerList(api)
+				_, _, attributes := l.item(models.GetNetworkLoadBalancerResp{})
+
+				return l, attributes
+			},
+			attribute: "load_balancers",
+			response: `{"loadBalancers":[` +
+				`{"id":4,"name":"lb-web","cloud":{"id":1}},` +
+				`{"id":5,"name":"lb-db","cloud":{"id":1}}],"meta":{"total":2}}`,
+			config:  map[string]interface{}{"name_regex": "^lb-w"},
+			wantIDs: []int{4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var phrase string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				phrase = r.URL.Query().Get(phraseKey)
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			list, attributes := tt.list(testCmpAPI(server))
+			d := utils.NewData(schema.TestResourceDataRaw(t, testListSchema(tt.attribute, attributes), tt.config))
+			if err := list.Read(context.Background(), d, nil); err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+			if phrase != tt.wantPhrase {
+				t.Errorf("phrase query param = %q, want %q", phrase, tt.wantPhrase)
+			}
+			if ids := d.ListToIntSlice("ids"); !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("ids = %v, want %v", ids, tt.wantIDs)
+			}
+			if got := len(d.GetListMap(tt.attribute)); got != len(tt.wantIDs) {
+				t.Errorf("%s has %d items, want %d", tt.attribute, got, len(tt.wantIDs))
+			}
+		})
+	}
+}
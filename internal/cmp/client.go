@@ -2,21 +2,35 @@
 
 package cmp
 
-import apiClient "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+import (
+	"context"
+
+	apiClient "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+)
 
 // Client is the cmp client which will implements all the
 // functions in interface.go
 type Client struct {
 	Instance                  Resource
 	InstanceClone             Resource
+	InstanceAction            Resource
+	InstanceGroup             Resource
 	Router                    Resource
 	ResNetwork                Resource
 	RouterNat                 Resource
 	RouterFirewallRuleGroup   Resource
+	RouterFirewallRule        Resource
+	DfwPolicy                 Resource
+	DfwRule                   Resource
+	NsxSecurityGroup          Resource
 	RouterRoute               Resource
 	RouterBgpNeighbor         Resource
 	LoadBalancer              Resource
 	DhcpServer                Resource
+	ResNetworkPool            Resource
+	ResIPAddress              Resource
+	ResNetworkDomain          Resource
+	ResDNSRecord              Resource
 	LoadBalancerMonitor       Resource
 	LoadBalancerProfile       Resource
 	LoadBalancerPool          Resource
@@ -49,73 +63,122 @@ type Client struct {
 	DSDhcpServer              DataSource
 	InstanceStorageType       DataSource
 	InstanceStorageController DataSource
+	NetworkList               DataSource
+	PlanList                  DataSource
+	TemplateList              DataSource
+	DatastoreList             DataSource
+	CloudList                 DataSource
+	GroupList                 DataSource
+	PowerScheduleList         DataSource
+	RouterList                DataSource
+	LoadBalancerList          DataSource
+
+	client *apiClient.APIClient
+	cache  *cache
 }
 
-// NewClient returns configured client
-func NewClient(client *apiClient.APIClient, cfg apiClient.Configuration) *Client {
+// NewClient returns configured client. pageSize is the page size used by the
+// list APIs, default page size is used if it is less than 1. renewToken is
+// true if the CMP token is renewed using the broker.
+func NewClient(client *apiClient.APIClient, cfg apiClient.Configuration, pageSize int, renewToken bool) *Client {
+	// cache is shared by all the resources and data sources of the provider
+	c := newCache()
+	api := newCmpAPI(client, cfg, c, pageSize, renewToken)
+
 	return &Client{
+		client: client,
+		cache:  c,
 		// Resources
 		Instance: newInstance(
 			&apiClient.InstancesAPIService{Client: client, Cfg: cfg},
 			&apiClient.ServersAPIService{Client: client, Cfg: cfg},
+			api,
 		),
 		InstanceClone: newInstanceClone(
 			&apiClient.InstancesAPIService{Client: client, Cfg: cfg},
 			&apiClient.ServersAPIService{Client: client, Cfg: cfg},
+			api,
+		),
+		InstanceAction: newInstanceAction(&apiClient.InstancesAPIService{Client: client, Cfg: cfg}, api),
+		InstanceGroup: newInstanceGroup(
+			&apiClient.InstancesAPIService{Client: client, Cfg: cfg},
+			&apiClient.ServersAPIService{Client: client, Cfg: cfg},
 		),
 		ResNetwork: newResNetwork(
 			&apiClient.NetworksAPIService{Client: client, Cfg: cfg},
 			&apiClient.RouterAPIService{Client: client, Cfg: cfg},
+			api,
 		),
+		ResNetworkPool:   newResNetworkPool(api),
+		ResIPAddress:     newResIPAddress(api),
+		ResNetworkDomain: newResNetworkDomain(api),
+		ResDNSRecord:     newResDNSRecord(api),
 		LoadBalancer: newLoadBalancer(
 			&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg},
-			&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
+			&apiClient.RouterAPIService{Client: client, Cfg: cfg}, c),
 		DhcpServer: newDhcpServer(
 			&apiClient.DhcpServerAPIService{Client: client, Cfg: cfg},
-			&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
-		LoadBalancerMonitor:       newLoadBalancerMonitor(&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg}),
+			&apiClient.RouterAPIService{Client: client, Cfg: cfg}, c),
+		LoadBalancerMonitor:       newLoadBalancerMonitor(&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg}, api),
 		LoadBalancerProfile:       newLoadBalancerProfile(&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg}),
 		LoadBalancerPool:          newLoadBalancerPool(&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg}),
 		LoadBalancerVirtualServer: newLoadBalancerVirtualServer(&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg}),
 
-		Router:                  newRouter(&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
-		RouterNat:               newRouterNat(&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
-		RouterFirewallRuleGroup: newRouterFirewallRuleGroup(&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
-		RouterRoute:             newRouterRoute(&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
+		Router:                  newRouter(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, c),
+		RouterNat:               newRouterNat(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api),
+		RouterFirewallRuleGroup: newRouterFirewallRuleGroup(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api),
+		RouterFirewallRule:      newRouterFirewallRule(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api),
+		DfwPolicy:               newDfwPolicy(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api, c),
+		DfwRule:                 newDfwRule(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api),
+		NsxSecurityGroup:        newNsxSecurityGroup(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api, c),
+		RouterRoute:             newRouterRoute(&apiClient.RouterAPIService{Client: client, Cfg: cfg}, api),
 		RouterBgpNeighbor:       newRouterBgpNeighbor(&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
 		// Datasource
-		Network:       newNetwork(&apiClient.NetworksAPIService{Client: client, Cfg: cfg}),
-		NetworkType:   newNetworkType(&apiClient.NetworksAPIService{Client: client, Cfg: cfg}),
-		NetworkPool:   newNetworkPool(&apiClient.NetworksAPIService{Client: client, Cfg: cfg}),
-		Plan:          newPlan(&apiClient.PlansAPIService{Client: client, Cfg: cfg}),
-		Group:         newGroup(&apiClient.GroupsAPIService{Client: client, Cfg: cfg}),
-		Layout:        newLayout(&apiClient.LibraryAPIService{Client: client, Cfg: cfg}),
-		Cloud:         newCloud(&apiClient.CloudsAPIService{Client: client, Cfg: cfg}),
-		ResourcePool:  newResourcePool(&apiClient.CloudsAPIService{Client: client, Cfg: cfg}),
-		Datastore:     newDatastore(&apiClient.CloudsAPIService{Client: client, Cfg: cfg}),
-		PowerSchedule: newPowerSchedule(&apiClient.PowerSchedulesAPIService{Client: client, Cfg: cfg}),
-		Template:      newTemplate(&apiClient.VirtualImagesAPIService{Client: client, Cfg: cfg}),
-		Environment:   newEnvironment(&apiClient.EnvironmentAPIService{Client: client, Cfg: cfg}),
+		Network:       newNetwork(api),
+		NetworkType:   newNetworkType(api),
+		NetworkPool:   newNetworkPool(api),
+		Plan:          newPlan(api),
+		Group:         newGroup(api),
+		Layout:        newLayout(api),
+		Cloud:         newCloud(api),
+		ResourcePool:  newResourcePool(api),
+		Datastore:     newDatastore(api),
+		PowerSchedule: newPowerSchedule(api),
+		Template:      newTemplate(api),
+		Environment:   newEnvironment(api),
 		NetworkInterface: newNetworkInterface(&apiClient.CloudsAPIService{Client: client, Cfg: cfg},
 			&apiClient.ProvisioningAPIService{Client: client, Cfg: cfg}),
-		CloudFolder:    newCloudFolder(&apiClient.CloudsAPIService{Client: client, Cfg: cfg}),
-		DSRouter:       newRouterDS(&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
-		DSLoadBalancer: newLoadBalancerDS(&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg}),
+		CloudFolder:    newCloudFolder(api),
+		DSRouter:       newRouterDS(api),
+		DSLoadBalancer: newLoadBalancerDS(api),
 		DSLBProfile:    newLBVirtualServerProfileDS(&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg}),
 		DSLBMonitor:    newLBMonitorDS(&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg}),
 		DSLBPool:       newLBPoolDS(&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg}),
 		DSPoolMemeberGroup: newLBPoolMemberGroupDS(
 			&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg},
-			&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
-		DSDhcpServer: newDHCPServerDS(
-			&apiClient.DhcpServerAPIService{Client: client, Cfg: cfg},
-			&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
+			&apiClient.RouterAPIService{Client: client, Cfg: cfg}, c),
+		DSDhcpServer:              newDHCPServerDS(api),
 		DSLBVirtualServerSslCert:  newLBsslVirtualServerCertDS(&apiClient.LoadBalancerAPIService{Client: client, Cfg: cfg}),
-		DSDomain:                  newDomain(&apiClient.DomainAPIService{Client: client, Cfg: cfg}),
-		NetworkProxy:              newNetworkProxy(&apiClient.NetworksAPIService{Client: client, Cfg: cfg}),
-		TransportZone:             newTransportZone(&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
-		EdgeCluster:               newEdgeCluster(&apiClient.RouterAPIService{Client: client, Cfg: cfg}),
+		DSDomain:                  newDomain(api),
+		NetworkProxy:              newNetworkProxy(api),
+		TransportZone:             newTransportZone(api),
+		EdgeCluster:               newEdgeCluster(api),
 		InstanceStorageType:       newInstanceStorageType(&apiClient.InstancesAPIService{Client: client, Cfg: cfg}),
 		InstanceStorageController: newInstanceStorageController(&apiClient.InstancesAPIService{Client: client, Cfg: cfg}),
+		NetworkList:               newNetworkList(api),
+		PlanList:                  newPlanList(api),
+		TemplateList:              newTemplateList(api),
+		DatastoreList:             newDatastoreList(api),
+		CloudList:                 newCloudList(api),
+		GroupList:                 newGroupList(api),
+		PowerScheduleList:         newPowerScheduleList(api),
+		RouterList:                newRouterList(api),
+		LoadBalancerList:          newLoadBalancerList(api),
 	}
 }
+
+// Capabilities returns the capabilities of CMP, which are computed once per
+// client from the CMP version
+func (c *Client) Capabilities(ctx context.Context) (*Capabilities, error) {
+	return c.cache.getCapabilities(ctx, c.client)
+}
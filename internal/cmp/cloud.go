@@ -1,45 +1,45 @@
-// // (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// // (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
-	"fmt"
-	"log"
 
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 )
 
-type cloud struct {
-	cloudClient *client.CloudsAPIService
+func cloudCatalogue(api *cmpAPI) catalogue[models.CloudRespBody] {
+	return catalogue[models.CloudRespBody]{
+		api:       api,
+		kind:      "cloud",
+		path:      func(d *utils.Data) string { return "zones" },
+		listKey:   "zones",
+		itemKey:   "zone",
+		nameQuery: true,
+		item: func(c models.CloudRespBody) (int, string, map[string]interface{}) {
+			return c.ID, c.Name, cloudAttributes(c)
+		},
+		dateCreated: func(c models.CloudRespBody) string { return c.Datecreated },
+	}
 }
 
-func newCloud(cloudClient *client.CloudsAPIService) *cloud {
-	return &cloud{
-		cloudClient: cloudClient,
+func newCloud(api *cmpAPI) *catalogueLookup[models.CloudRespBody] {
+	return &catalogueLookup[models.CloudRespBody]{
+		catalogue: cloudCatalogue(api),
 	}
 }
 
-func (c *cloud) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, c.cloudClient.Client)
-	log.Printf("[INFO] Get Cloud")
-
-	name := d.GetString("name")
-	if err := d.Error(); err != nil {
-		return err
-	}
-	cloud, err := c.cloudClient.GetAllClouds(ctx, map[string]string{
-		nameKey: name,
-	})
-	if err != nil {
-		return err
+func newCloudList(api *cmpAPI) *catalogueList[models.CloudRespBody] {
+	return &catalogueList[models.CloudRespBody]{
+		catalogue: cloudCatalogue(api),
+		attribute: "clouds",
+		filter: func(ctx context.Context, d *utils.Data) (func(models.CloudRespBody) bool, error) {
+			typeCode := d.GetString("type_code")
+
+			return func(c models.CloudRespBody) bool {
+				return typeCode == "" || c.Zonetype.Code == typeCode
+			}, nil
+		},
 	}
-	if len(cloud.Clouds) != 1 {
-		return fmt.Errorf(errExactMatch, "clouds")
-	}
-	d.SetID(cloud.Clouds[0].ID)
-
-	// post check
-	return d.Error()
 }
@@ -3,51 +3,34 @@
 package cmp
 
 import (
-	"context"
 	"fmt"
-	"log"
 
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 )
 
-type cloudFolder struct {
-	fClient *client.CloudsAPIService
-}
-
-func newCloudFolder(fClient *client.CloudsAPIService) *cloudFolder {
-	return &cloudFolder{
-		fClient: fClient,
-	}
-}
-
-func (f *cloudFolder) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, f.fClient.Client)
-	log.Printf("[INFO] Get cloud folder")
-
-	name := d.GetString("name")
-	cloudID := d.GetInt("cloud_id")
-	// Pre check
-	if err := d.Error(); err != nil {
-		return err
+func newCloudFolder(api *cmpAPI) *catalogueLookup[models.GetCloudFolder] {
+	return &catalogueLookup[models.GetCloudFolder]{
+		catalogue: catalogue[models.GetCloudFolder]{
+			api:  api,
+			kind: "folder",
+			path: func(d *utils.Data) string {
+				return fmt.Sprintf("zones/%d/folders", d.GetInt("cloud_id"))
+			},
+			listKey: "folders",
+			itemKey: "folder",
+			item: func(f models.GetCloudFolder) (int, string, map[string]interface{}) {
+				return f.ID, f.Name, map[string]interface{}{
+					// code is the external ID of the folder
+					"code":           f.ExternalID,
+					"type":           f.Type,
+					"default_folder": f.DefaultFolder,
+					"default_store":  f.DefaultStore,
+					"read_only":      f.ReadOnly,
+					"active":         f.Active,
+					"visibility":     f.Visibility,
+				}
+			},
+		},
 	}
-	param := map[string]string{maxKey: "2000"} // There could be many folders, and max=-1 doesn't return any data
-	folders, err := f.fClient.GetAllCloudFolders(ctx, cloudID, param)
-	if err != nil {
-		return err
-	}
-
-	for _, cf := range folders.Folders {
-		if cf.Name == name {
-			err = d.Set("code", cf.ExternalID)
-			if err != nil {
-				return err
-			}
-			d.SetID(cf.ID)
-
-			return nil
-		}
-	}
-
-	return fmt.Errorf(errExactMatch, "Folder")
 }
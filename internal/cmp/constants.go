@@ -11,6 +11,9 @@ const (
 	nsxSegment    = "Segment"
 	errExactMatch = "error, could not find the %s with the specified name. Please verify the name and try again"
 	successErr    = "got success = 'false while %s"
+
+	errMultipleMatch = "error, more than one %s matched the given arguments: %s. " +
+		"Please use a more specific filter or set most_recent"
 	// query params keys
 	provisionTypeKey = "provisionType"
 	codeKey          = "code"
@@ -18,13 +21,59 @@ const (
 	maxKey           = "max"
 	externalNameKey  = "externalName"
 	filterTypeKey    = "filterType"
+	offsetKey        = "offset"
+	phraseKey        = "phrase"
+	// filter names
+	phraseFilter = "phrase"
+	// pagination consts
+	defaultPageSize = 100
+	// bytes in a GB, CMP returns the sizes in bytes
+	bytesPerGB = 1024 * 1024 * 1024
+	// cache consts
+	catalogueCacheTTL       = time.Minute * 5
+	networkServicesCacheKey = "networks/services"
+	capabilitiesCacheKey    = "whoami"
+	networksCachePath       = "networks"
+	routersCachePath        = "networks/routers"
+	loadBalancersCachePath  = "load-balancers"
+	networkPoolsCachePath   = "networks/pools"
+	networkDomainsCachePath = "networks/domains"
 	// retry related constants
 	maxTimeout = time.Hour * 2
+	// instance power operation timeout
+	instancePowerTimeout = time.Minute * 20
+	// timeout for an instance operation to create the history entry
+	instanceHistoryTimeout = time.Minute * 2
+	// instance delete query params keys
+	instanceDeletePreserveVolumesKey = "preserveVolumes"
+	instanceDeleteKeepBackupsKey     = "keepBackups"
+	instanceDeleteReleaseIPsKey      = "releaseEIPs"
+	instanceDeleteForceKey           = "force"
+	// instance action consts
+	instanceActionWorkflowKey = "workflowId"
+	// action codes of PUT /api/instances/:id/action, same as the codes listed by
+	// GET /api/instances/:id/actions
+	instanceActionResetCode  = "generic-reset"
+	instanceActionAgentCode  = "agent-refresh"
+	instanceActionTargetType = "instance"
+	// job executions of tasks, GET /api/job-executions/:id
+	instanceJobExecutionsPath = "job-executions"
+	// ipMode of network interfaces with static IP address
+	instanceStaticIPMode = "static"
 	// router consts
 	tier0GatewayType             = "Tier-0 Gateway"
 	tier1GatewayType             = "Tier-1 Gateway"
 	routerFirewallExternalPolicy = "GatewayPolicy"
 	syncedTypeValue              = "Synced"
+	routerFirewallRulesPath      = "rules"
+	// distributed firewall consts
+	dfwExternalPolicy     = "SecurityPolicy"
+	nsxSecurityGroupsPath = "groups"
+	nsxGroupMemberTypeVM  = "VirtualMachine"
+	// network pool consts
+	networkPoolIPsPath = "ips"
+	// network domain consts
+	networkDomainRecordsPath = "records"
 
 	// load balancer consts
 	TCP      = "tcp"
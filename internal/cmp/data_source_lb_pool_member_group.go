@@ -15,13 +15,15 @@ import (
 type poolMemberGroupds struct {
 	lbClient *client.LoadBalancerAPIService
 	rClient  *client.RouterAPIService
+	cache    *cache
 }
 
 func newLBPoolMemberGroupDS(loadBalancerClient *client.LoadBalancerAPIService,
-	routerClient *client.RouterAPIService) *poolMemberGroupds {
+	routerClient *client.RouterAPIService, c *cache) *poolMemberGroupds {
 	return &poolMemberGroupds{
 		lbClient: loadBalancerClient,
 		rClient:  routerClient,
+		cache:    c,
 	}
 }
 
@@ -34,13 +36,13 @@ func (n *poolMemberGroupds) Read(ctx context.Context, d *utils.Data, meta interf
 	if err := d.Error(); err != nil {
 		return err
 	}
-	nsxType, err := GetNsxTypeFromCMP(ctx, n.rClient.Client)
+	nsxType, err := n.cache.getNsxType(ctx, n.rClient.Client)
 	if err != nil {
 		return err
 	}
 	setMeta(meta, n.rClient.Client)
 	// Get network server ID for nsx-t
-	serverResp, err := n.rClient.GetNetworkServices(ctx, nil)
+	serverResp, err := n.cache.getNetworkServices(ctx, n.rClient)
 	if err != nil {
 		return err
 	}
@@ -1,47 +1,69 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
-	"errors"
-	"log"
+	"fmt"
 
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 )
 
-type datastore struct {
-	nClient *client.CloudsAPIService
+// datastoreCatalogue fetches datastores directly from CMP API, since sdk
+// model of datastore does not contain the capacity
+func datastoreCatalogue(api *cmpAPI) catalogue[cmpDatastore] {
+	return catalogue[cmpDatastore]{
+		api:  api,
+		kind: "datastore",
+		path: func(d *utils.Data) string {
+			return fmt.Sprintf("zones/%d/data-stores", d.GetInt("cloud_id"))
+		},
+		listKey:   "datastores",
+		itemKey:   "datastore",
+		nameQuery: true,
+		item: func(ds cmpDatastore) (int, string, map[string]interface{}) {
+			return ds.ID, ds.Name, datastoreAttributes(ds)
+		},
+		dateCreated: func(ds cmpDatastore) string { return ds.DateCreated },
+	}
 }
 
-func newDatastore(nClient *client.CloudsAPIService) *datastore {
-	return &datastore{nClient: nClient}
+func newDatastore(api *cmpAPI) *catalogueLookup[cmpDatastore] {
+	return &catalogueLookup[cmpDatastore]{
+		catalogue: datastoreCatalogue(api),
+		filter:    datastoreFilter,
+		order: func(d *utils.Data) func(a, b cmpDatastore) bool {
+			if !d.GetBool("most_free_space") {
+				return nil
+			}
+
+			return func(a, b cmpDatastore) bool {
+				return a.FreeSpace > b.FreeSpace
+			}
+		},
+	}
 }
 
-func (n *datastore) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, n.nClient.Client)
-	log.Printf("[INFO] Get Datastore")
+func newDatastoreList(api *cmpAPI) *catalogueList[cmpDatastore] {
+	return &catalogueList[cmpDatastore]{
+		catalogue: datastoreCatalogue(api),
+		attribute: "datastores",
+		filter:    datastoreFilter,
+	}
+}
 
-	// name := d.GetString("name")
-	cloudID := d.GetInt("cloud_id")
-	name := d.GetString("name")
+// datastoreFilter matches the datastores with the type, all the tags and at
+// least min_free_gb of free space
+func datastoreFilter(_ context.Context, d *utils.Data) (func(cmpDatastore) bool, error) {
+	dsType := d.GetString("type")
+	tags := d.GetMap("tags")
+	minFree := int64(d.GetInt("min_free_gb")) * bytesPerGB
 
-	// Pre check
-	if err := d.Error(); err != nil {
-		return err
-	}
-	datastores, err := n.nClient.GetAllCloudDataStores(ctx, cloudID,
-		map[string]string{"name": name},
-	)
-	if err != nil {
-		return err
-	}
-	if len(datastores.Datastores) != 1 {
-		return errors.New("error coudn't find exact datastore, please check the name")
-	}
-	d.SetID(datastores.Datastores[0].ID)
+	return func(ds cmpDatastore) bool {
+		if (dsType != "" && ds.Type != dsType) || ds.FreeSpace < minFree {
+			return false
+		}
 
-	// post check
-	return d.Error()
+		return hasTags(ds.Tags, tags)
+	}, d.Error()
 }
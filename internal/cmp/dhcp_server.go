@@ -1,43 +1,69 @@
-// (C) Copyright 2022 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2022-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
 	"github.com/tshihad/tftags"
 )
 
 type dhcpServer struct {
 	dhcpClient *client.DhcpServerAPIService
 	rClient    *client.RouterAPIService
+	cache      *cache
 }
 
-func newDhcpServer(dhcpServerClient *client.DhcpServerAPIService, routerClient *client.RouterAPIService) *dhcpServer {
+func newDhcpServer(
+	dhcpServerClient *client.DhcpServerAPIService,
+	routerClient *client.RouterAPIService,
+	c *cache,
+) *dhcpServer {
 	return &dhcpServer{
 		dhcpClient: dhcpServerClient,
 		rClient:    routerClient,
+		cache:      c,
 	}
 }
 
 func (dhcp *dhcpServer) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
 	setMeta(meta, dhcp.dhcpClient.Client)
-	var dhcpServerResp models.CreateNetworkDhcpServer
-	if err := tftags.Get(d, &dhcpServerResp); err != nil {
-		return err
+	// network_server_id is not set on import
+	serverID := d.GetInt("network_server_id")
+	if serverID == 0 {
+		var err error
+		if serverID, err = dhcp.cache.getNsxNetworkServerID(ctx, dhcp.rClient); err != nil {
+			return err
+		}
+		d.Set("network_server_id", serverID)
 	}
-	getdhcpServerResp, err := dhcp.dhcpClient.GetSpecificDhcpServer(ctx, dhcpServerResp.NetworkServerID,
-		dhcpServerResp.ID)
+	getdhcpServerResp, err := dhcp.dhcpClient.GetSpecificDhcpServer(ctx, serverID, d.GetID())
 	if err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	dhcpServer := getdhcpServerResp.GetSpecificNetworkDhcpServerResp
+	if err := tftags.Set(d, dhcpServer); err != nil {
 		return err
 	}
+	d.SetString("name", dhcpServer.Name)
+	d.Set("lease_time", dhcpServer.LeaseTime)
+	d.SetString("server_address", dhcpServer.ServerIPAddress)
 
-	return tftags.Set(d, getdhcpServerResp.GetSpecificNetworkDhcpServerResp)
+	return d.Error()
 }
 
 func (dhcp *dhcpServer) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -122,14 +148,14 @@ func (dhcp *dhcpServer) Delete(ctx context.Context, d *utils.Data, meta interfac
 func (dhcp *dhcpServer) dhcpServerAlignRequest(ctx context.Context, meta interface{},
 	createReq *models.CreateNetworkDhcpServerRequest) error {
 	// Get network service ID
-	nsxType, err := GetNsxTypeFromCMP(ctx, dhcp.rClient.Client)
+	nsxType, err := dhcp.cache.getNsxType(ctx, dhcp.rClient.Client)
 	if err != nil {
 		return err
 	}
 	setMeta(meta, dhcp.rClient.Client)
 	nsRetry := utils.CustomRetry{}
 	nsRetry.RetryParallel(ctx, meta, func(ctx context.Context) (interface{}, error) {
-		return dhcp.rClient.GetNetworkServices(ctx, nil)
+		return dhcp.cache.getNetworkServices(ctx, dhcp.rClient)
 	})
 
 	// Align Network Server
@@ -1,75 +1,33 @@
-// (C) Copyright 2022 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2022-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
-	"context"
-	"fmt"
-	"log"
+	"time"
 
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
-	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
-	"github.com/tshihad/tftags"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 )
 
-type dhcpServerds struct {
-	dhcpClient *client.DhcpServerAPIService
-	rClient    *client.RouterAPIService
-}
-
-func newDHCPServerDS(dhcpServerClient *client.DhcpServerAPIService,
-	routerClient *client.RouterAPIService) *dhcpServerds {
-	return &dhcpServerds{
-		dhcpClient: dhcpServerClient,
-		rClient:    routerClient,
-	}
-}
-
-func (n *dhcpServerds) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, n.dhcpClient.Client)
-	log.Printf("[DEBUG] Get DHCP Server")
-	name := d.GetString("name")
-
-	// Pre check
-	if err := d.Error(); err != nil {
-		return err
-	}
-	nsxType, err := GetNsxTypeFromCMP(ctx, n.rClient.Client)
-	if err != nil {
-		return err
-	}
-	setMeta(meta, n.rClient.Client)
-	// Get network server ID for nsx-t
-	serverResp, err := n.rClient.GetNetworkServices(ctx, nil)
-	if err != nil {
-		return err
+// newDHCPServerDS looks up the DHCP servers of the NSX network server
+func newDHCPServerDS(api *cmpAPI) *catalogueLookup[models.GetNetworkDhcpServerResp] {
+	return &catalogueLookup[models.GetNetworkDhcpServerResp]{
+		catalogue: catalogue[models.GetNetworkDhcpServerResp]{
+			api:         api,
+			kind:        "DHCP server",
+			pathContext: nsxServerPath(api, "dhcp-servers"),
+			listKey:     "networkDhcpServers",
+			itemKey:     "networkDhcpServer",
+			item: func(s models.GetNetworkDhcpServerResp) (int, string, map[string]interface{}) {
+				return s.ID, s.Name, map[string]interface{}{
+					"provider_id":       s.ProviderID,
+					"external_id":       s.ExternalID,
+					"server_ip_address": s.ServerIPAddress,
+					"lease_time":        s.LeaseTime,
+				}
+			},
+			dateCreated: func(s models.GetNetworkDhcpServerResp) string {
+				return s.DateCreated.Format(time.RFC3339)
+			},
+		},
 	}
-
-	var serverID int
-	for i, n := range serverResp.NetworkServices {
-		if n.TypeName == nsxType {
-			serverID = serverResp.NetworkServices[i].ID
-
-			break
-		}
-	}
-
-	if serverID == 0 {
-		return fmt.Errorf(errExactMatch, "network server")
-	}
-
-	dh, err := n.dhcpClient.GetDhcpServers(ctx, serverID)
-	if err != nil {
-		return err
-	}
-
-	for i, n := range dh.GetNetworkDhcpServerResp {
-		if n.Name == name {
-			log.Print("[DEBUG]", dh.GetNetworkDhcpServerResp[i].ProviderID)
-
-			return tftags.Set(d, dh.GetNetworkDhcpServerResp[i])
-		}
-	}
-
-	return fmt.Errorf(errExactMatch, "DHCP Server")
 }
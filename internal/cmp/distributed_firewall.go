@@ -0,0 +1,212 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
+)
+
+// dfwPolicy manages the NSX-T distributed firewall security policies. The
+// policies are the firewall rule groups of the NSX-T network server, with
+// SecurityPolicy external type. The sdk supports the firewall rule groups of
+// the routers only, so the policies are managed using cmpAPI.
+type dfwPolicy struct {
+	rClient *client.RouterAPIService
+	api     *cmpAPI
+	cache   *cache
+}
+
+// dfwRule manages the rules of the distributed firewall security policies.
+// Network server of the rule is the network server of the parent policy.
+type dfwRule struct {
+	rClient *client.RouterAPIService
+	api     *cmpAPI
+}
+
+func newDfwPolicy(rClient *client.RouterAPIService, api *cmpAPI, c *cache) *dfwPolicy {
+	return &dfwPolicy{
+		rClient: rClient,
+		api:     api,
+		cache:   c,
+	}
+}
+
+func newDfwRule(rClient *client.RouterAPIService, api *cmpAPI) *dfwRule {
+	return &dfwRule{
+		rClient: rClient,
+		api:     api,
+	}
+}
+
+func dfwPoliciesPath(serverID int) string {
+	return fmt.Sprintf("%s/%s/%d/%s", consts.NetworksPath, consts.ServerPath, serverID,
+		consts.RoutersFirewallRuleGroupPath)
+}
+
+func (p *dfwPolicy) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, p.rClient.Client)
+	var resp models.GetSpecificRouterFirewallRuleGroupResponse
+	path := fmt.Sprintf("%s/%d", dfwPoliciesPath(d.GetInt("network_server_id")), d.GetID())
+	if err := p.api.do(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	policy := resp.GetSpecificRouterFirewallRuleGroup
+	d.SetString("name", policy.Name)
+	d.SetString("description", policy.Description)
+	d.Set("priority", policy.Priority)
+	d.SetString("category", policy.GroupLayer)
+
+	return d.Error()
+}
+
+func (p *dfwPolicy) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, p.rClient.Client)
+	serverID, err := p.cache.getNsxNetworkServerID(ctx, p.rClient)
+	if err != nil {
+		return err
+	}
+
+	var resp models.SuccessOrErrorMessage
+	if err = p.api.do(ctx, http.MethodPost, dfwPoliciesPath(serverID), nil, p.getPolicy(d), &resp); err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating distributed firewall policy")
+	}
+	d.Set("network_server_id", serverID)
+	d.SetID(resp.ID)
+
+	return d.Error()
+}
+
+func (p *dfwPolicy) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, p.rClient.Client)
+	var resp models.SuccessOrErrorMessage
+	path := fmt.Sprintf("%s/%d", dfwPoliciesPath(d.GetInt("network_server_id")), d.GetID())
+	if err := p.api.do(ctx, http.MethodPut, path, nil, p.getPolicy(d), &resp); err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating distributed firewall policy")
+	}
+
+	return nil
+}
+
+func (p *dfwPolicy) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, p.rClient.Client)
+	var resp models.SuccessOrErrorMessage
+	path := fmt.Sprintf("%s/%d", dfwPoliciesPath(d.GetInt("network_server_id")), d.GetID())
+	if err := p.api.do(ctx, http.MethodDelete, path, nil, nil, &resp); err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("got success = 'false' while deleting distributed firewall policy, error: %s", resp.Msg)
+	}
+
+	return nil
+}
+
+func (p *dfwPolicy) getPolicy(d *utils.Data) models.CreateRouterFirewallRuleGroupRequest {
+	return models.CreateRouterFirewallRuleGroupRequest{
+		CreateRouterFirewallRuleGroup: models.CreateRouterFirewallRuleGroup{
+			Name:         d.GetString("name"),
+			Description:  d.GetString("description"),
+			Priority:     d.GetInt("priority"),
+			GroupLayer:   d.GetString("category"),
+			ExternalType: dfwExternalPolicy,
+		},
+	}
+}
+
+func (r *dfwRule) path(d *utils.Data) string {
+	return fmt.Sprintf("%s/%d/%s", dfwPoliciesPath(d.GetInt("network_server_id")), d.GetInt("policy_id"),
+		routerFirewallRulesPath)
+}
+
+func (r *dfwRule) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.rClient.Client)
+	var resp firewallRuleResp
+	if err := r.api.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", r.path(d), d.GetID()), nil, nil, &resp); err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	setFirewallRule(d, resp.Rule)
+	d.Set("applied_to", resp.Rule.Config.Scope)
+
+	return d.Error()
+}
+
+func (r *dfwRule) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.rClient.Client)
+	var resp firewallRuleResp
+	if err := r.api.do(ctx, http.MethodPost, r.path(d), nil, firewallRuleBody{Rule: r.getRule(d)}, &resp); err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating distributed firewall rule")
+	}
+	id := resp.ID
+	if id == 0 {
+		id = resp.Rule.ID
+	}
+	d.SetID(id)
+
+	return d.Error()
+}
+
+func (r *dfwRule) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.rClient.Client)
+	var resp models.SuccessOrErrorMessage
+	err := r.api.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d", r.path(d), d.GetID()), nil,
+		firewallRuleBody{Rule: r.getRule(d)}, &resp)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating distributed firewall rule")
+	}
+
+	return nil
+}
+
+func (r *dfwRule) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.rClient.Client)
+	var resp models.SuccessOrErrorMessage
+	if err := r.api.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", r.path(d), d.GetID()), nil, nil, &resp); err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("got success = 'false' while deleting distributed firewall rule, error: %s", resp.Msg)
+	}
+
+	return nil
+}
+
+func (r *dfwRule) getRule(d *utils.Data) firewallRule {
+	rule := getFirewallRule(d)
+	rule.Config.Scope = d.GetStringList("applied_to")
+
+	return rule
+}
@@ -1,49 +1,29 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
-	"context"
-	"errors"
-	"log"
-
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
-	"github.com/tshihad/tftags"
 )
 
-type domain struct {
-	dClient *client.DomainAPIService
-}
-
-func newDomain(dClient *client.DomainAPIService) *domain {
-	return &domain{dClient: dClient}
-}
-
-func (n *domain) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, n.dClient.Client)
-	log.Printf("[INFO] Get Domain")
-
-	name := d.GetString("name")
-
-	// Pre check
-	if err := d.Error(); err != nil {
-		return err
-	}
-	// Get all domain with filter as name
-	domains, err := n.dClient.GetAllDomains(ctx, map[string]string{nameKey: name})
-	if err != nil {
-		return err
-	}
-	if len(domains.NetworkDomains) != 1 {
-		return errors.New("error coudn't find exact domain, please check the name")
+func newDomain(api *cmpAPI) *catalogueLookup[models.GetDomain] {
+	return &catalogueLookup[models.GetDomain]{
+		catalogue: catalogue[models.GetDomain]{
+			api:       api,
+			kind:      "network domain",
+			path:      func(d *utils.Data) string { return networkDomainsCachePath },
+			listKey:   "networkDomains",
+			itemKey:   "networkDomain",
+			nameQuery: true,
+			item: func(dm models.GetDomain) (int, string, map[string]interface{}) {
+				return dm.ID, dm.Name, map[string]interface{}{
+					"active":            dm.Active,
+					"visibility":        dm.Visibility,
+					"domain_controller": dm.DomainController,
+					"public_zone":       dm.PublicZone,
+				}
+			},
+		},
 	}
-
-	err = tftags.Set(d, domains.NetworkDomains[0])
-	if err != nil {
-		return err
-	}
-
-	// post check
-	return d.Error()
 }
@@ -1,62 +1,34 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
-	"context"
-	"fmt"
-	"log"
-
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
-	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
-	"github.com/tshihad/tftags"
 )
 
-type edgeCluster struct {
-	tClient *client.RouterAPIService
-}
-
-func newEdgeCluster(tClient *client.RouterAPIService) *edgeCluster {
-	return &edgeCluster{
-		tClient: tClient,
-	}
-}
-
-func (r *edgeCluster) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	nsxType, err := GetNsxTypeFromCMP(ctx, r.tClient.Client)
-	if err != nil {
-		return err
-	}
-	setMeta(meta, r.tClient.Client)
-	log.Printf("[INFO] Get Edge Cluster")
-	var tfEdgeCluster models.NetworkEdgeClusters
-	if err := tftags.Get(d, &tfEdgeCluster); err != nil {
-		return err
-	}
-
-	// Get network server ID for nsx-t
-	serverResp, err := r.tClient.GetNetworkServices(ctx, nil)
-	if err != nil {
-		return err
-	}
-
-	var serverID int
-	for i, n := range serverResp.NetworkServices {
-		if n.TypeName == nsxType {
-			serverID = serverResp.NetworkServices[i].ID
-
-			break
-		}
+// newEdgeCluster looks up the edge clusters of the NSX network server.
+// CMP does not support getting a single edge cluster.
+func newEdgeCluster(api *cmpAPI) *catalogueLookup[models.NetworkEdgeClusters] {
+	return &catalogueLookup[models.NetworkEdgeClusters]{
+		catalogue: catalogue[models.NetworkEdgeClusters]{
+			api:         api,
+			kind:        "edge cluster",
+			pathContext: nsxServerPath(api, "edge-clusters"),
+			listKey:     "networkEdgeClusters",
+			item: func(e models.NetworkEdgeClusters) (int, string, map[string]interface{}) {
+				return e.ID, e.Name, map[string]interface{}{
+					"display_name": e.DisplayName,
+					"description":  e.Description,
+					"internal_id":  e.InternalID,
+					"external_id":  e.ExternalID,
+					"provider_id":  e.ProviderID,
+					"enabled":      e.Enabled,
+					"active":       e.Active,
+					"visibility":   e.Visibility,
+					"cloud_id":     e.Zone.ID,
+				}
+			},
+			dateCreated: func(e models.NetworkEdgeClusters) string { return e.DateCreated },
+		},
 	}
-
-	if serverID == 0 {
-		return fmt.Errorf(errExactMatch, "network server")
-	}
-	resp, err := r.tClient.GetEdgeCluster(ctx, serverID, tfEdgeCluster.Name)
-	if err != nil {
-		return err
-	}
-
-	return tftags.Set(d, resp)
 }
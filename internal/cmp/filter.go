@@ -0,0 +1,228 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+)
+
+// lookupFilter holds the common filter arguments of data sources, i.e. id,
+// name, name_prefix, name_regex, filter blocks and most_recent
+type lookupFilter struct {
+	id         int
+	name       string
+	namePrefix string
+	nameRegex  *regexp.Regexp
+	filters    map[string][]string
+	mostRecent bool
+}
+
+// lookupCandidate is an item which is matched against the lookupFilter
+type lookupCandidate struct {
+	id          int
+	name        string
+	attributes  map[string]interface{}
+	dateCreated string
+	// item is the CMP object of the candidate
+	item interface{}
+}
+
+// getLookupFilter parses the filter arguments. id, name and most_recent are
+// parsed only for the data sources which returns a single item.
+func getLookupFilter(d *utils.Data, isLookup bool) (*lookupFilter, error) {
+	f := &lookupFilter{
+		filters: make(map[string][]string),
+	}
+	if isLookup {
+		if id := d.GetString("id"); id != "" {
+			var err error
+			if f.id, err = strconv.Atoi(id); err != nil {
+				return nil, fmt.Errorf("invalid id %s, id should be an integer", id)
+			}
+		}
+		f.name = d.GetString("name")
+		f.mostRecent = d.GetBool("most_recent")
+	}
+	f.namePrefix = d.GetString("name_prefix")
+
+	if nameRegex := d.GetString("name_regex"); nameRegex != "" {
+		var err error
+		if f.nameRegex, err = regexp.Compile(nameRegex); err != nil {
+			return nil, fmt.Errorf("invalid name_regex %s: %w", nameRegex, err)
+		}
+	}
+
+	for _, filter := range d.GetListMap("filter") {
+		name, _ := filter["name"].(string)
+		values, _ := filter["values"].([]interface{})
+		for _, v := range values {
+			f.filters[name] = append(f.filters[name], fmt.Sprint(v))
+		}
+	}
+
+	return f, d.Error()
+}
+
+// queryParams returns the query params for the filters which are supported
+// by CMP. A filter is sent to CMP only if it contains a single value, since
+// CMP does not support multiple values for a query param. Filters with
+// multiple values are matched by the client if they are attributes of the
+// data source, otherwise they are rejected by validate.
+func (f *lookupFilter) queryParams(queryFilters map[string]string) map[string]string {
+	params := make(map[string]string)
+	for name, values := range f.filters {
+		if param, ok := queryFilters[name]; ok && len(values) == 1 {
+			params[param] = values[0]
+		}
+	}
+
+	return params
+}
+
+// validate checks all the filter names are either attributes of the data
+// source or supported query params. Since the query params are matched by CMP
+// only, the filters which are not attributes can have a single value only.
+func (f *lookupFilter) validate(attributes map[string]interface{}, queryFilters map[string]string) error {
+	for name, values := range f.filters {
+		if _, ok := attributes[name]; ok || name == "id" || name == "name" {
+			continue
+		}
+		if _, ok := queryFilters[name]; ok {
+			if len(values) > 1 {
+				return fmt.Errorf("filter %s supports a single value only, got %d values", name, len(values))
+			}
+
+			continue
+		}
+
+		supported := []string{"id", "name"}
+		for k := range attributes {
+			supported = append(supported, k)
+		}
+		for k := range queryFilters {
+			supported = append(supported, k)
+		}
+		sort.Strings(supported)
+
+		return fmt.Errorf("unsupported filter %s, supported filters are %s", name, strings.Join(supported, ", "))
+	}
+
+	return nil
+}
+
+// match checks whether the candidate satisfies all the filters. Multiple
+// values of a filter are ORed, and different filters are ANDed. Filters which
+// are not an attribute of the data source are assumed to be already applied by CMP.
+func (f *lookupFilter) match(c lookupCandidate) bool {
+	if f.id != 0 && c.id != f.id {
+		return false
+	}
+	if f.name != "" && c.name != f.name {
+		return false
+	}
+	if !strings.HasPrefix(c.name, f.namePrefix) {
+		return false
+	}
+	if f.nameRegex != nil && !f.nameRegex.MatchString(c.name) {
+		return false
+	}
+
+	for name, values := range f.filters {
+		var attr interface{}
+		switch name {
+		case "id":
+			attr = c.id
+		case "name":
+			attr = c.name
+		default:
+			var ok bool
+			if attr, ok = c.attributes[name]; !ok {
+				continue
+			}
+		}
+		if !matchFilterValues(attr, values) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchFilterValues(attr interface{}, values []string) bool {
+	// list attributes matches if any of the element matches
+	var attrValues []string
+	switch v := attr.(type) {
+	case []int:
+		for _, i := range v {
+			attrValues = append(attrValues, strconv.Itoa(i))
+		}
+	case []string:
+		attrValues = v
+	case map[string]string:
+		// map attributes such as tags matches either the key or key=value
+		for k, val := range v {
+			attrValues = append(attrValues, k, k+"="+val)
+		}
+	default:
+		attrValues = []string{fmt.Sprint(v)}
+	}
+
+	for _, attrValue := range attrValues {
+		for _, v := range values {
+			if attrValue == v {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// selectCandidate returns the index of the only candidate. If there are
+// multiple candidates, the most recently created one is returned when
+// most_recent is set, otherwise an error listing all the candidates is returned.
+func (f *lookupFilter) selectCandidate(kind string, candidates []lookupCandidate) (int, error) {
+	switch len(candidates) {
+	case 0:
+		return 0, fmt.Errorf(errExactMatch, kind)
+	case 1:
+		return 0, nil
+	}
+
+	if f.mostRecent {
+		latest := 0
+		for i := range candidates {
+			if isCreatedAfter(candidates[i].dateCreated, candidates[latest].dateCreated) {
+				latest = i
+			}
+		}
+
+		return latest, nil
+	}
+
+	names := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		names = append(names, fmt.Sprintf("%s (id: %d)", c.name, c.id))
+	}
+
+	return 0, fmt.Errorf(errMultipleMatch, kind, strings.Join(names, ", "))
+}
+
+// isCreatedAfter compares the creation dates returned by CMP. Dates are
+// compared as strings if they are not in RFC3339 format.
+func isCreatedAfter(date, other string) bool {
+	t, err1 := time.Parse(time.RFC3339, date)
+	o, err2 := time.Parse(time.RFC3339, other)
+	if err1 != nil || err2 != nil {
+		return date > other
+	}
+
+	return t.After(o)
+}
@@ -0,0 +1,180 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestLookupFilterMatch(t *testing.T) {
+	candidate := lookupCandidate{
+		id:   7,
+		name: "web-server",
+		attributes: map[string]interface{}{
+			"type": "vmware",
+			"zone": []int{1, 2},
+			"tags": map[string]string{"env": "prod"},
+		},
+	}
+	tests := []struct {
+		name   string
+		filter lookupFilter
+		want   bool
+	}{
+		{
+			name:   "Test case 1: empty filter",
+			filter: lookupFilter{},
+			want:   true,
+		},
+		{
+			name:   "Test case 2: id mismatch",
+			filter: lookupFilter{id: 8},
+			want:   false,
+		},
+		{
+			name:   "Test case 3: name prefix",
+			filter: lookupFilter{namePrefix: "web-"},
+			want:   true,
+		},
+		{
+			name:   "Test case 4: name regex mismatch",
+			filter: lookupFilter{nameRegex: regexp.MustCompile("^db-")},
+			want:   false,
+		},
+		{
+			name:   "Test case 5: filter values are ORed",
+			filter: lookupFilter{filters: map[string][]string{"type": {"kvm", "vmware"}}},
+			want:   true,
+		},
+		{
+			name: "Test case 6: filters are ANDed",
+			filter: lookupFilter{filters: map[string][]string{
+				"type": {"vmware"},
+				"name": {"app-server"},
+			}},
+			want: false,
+		},
+		{
+			name:   "Test case 7: list attribute",
+			filter: lookupFilter{filters: map[string][]string{"zone": {"2"}}},
+			want:   true,
+		},
+		{
+			name:   "Test case 8: map attribute key=value",
+			filter: lookupFilter{filters: map[string][]string{"tags": {"env=dev"}}},
+			want:   false,
+		},
+		{
+			name:   "Test case 9: query filter is applied by CMP",
+			filter: lookupFilter{filters: map[string][]string{"phrase": {"other"}}},
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.match(candidate); got != tt.want {
+				t.Errorf("match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupFilterValidate(t *testing.T) {
+	attributes := map[string]interface{}{"type": ""}
+	queryFilters := map[string]string{"phrase": "phrase"}
+	tests := []struct {
+		name    string
+		filters map[string][]string
+		params  map[string]string
+		wantErr bool
+	}{
+		{
+			name:    "Test case 1: attribute with multiple values",
+			filters: map[string][]string{"type": {"kvm", "vmware"}},
+			params:  map[string]string{},
+			wantErr: false,
+		},
+		{
+			name:    "Test case 2: query filter with a single value",
+			filters: map[string][]string{"phrase": {"web"}},
+			params:  map[string]string{"phrase": "web"},
+			wantErr: false,
+		},
+		{
+			name:    "Test case 3: query filter with multiple values",
+			filters: map[string][]string{"phrase": {"web", "db"}},
+			params:  map[string]string{},
+			wantErr: true,
+		},
+		{
+			name:    "Test case 4: unsupported filter",
+			filters: map[string][]string{"size": {"1"}},
+			params:  map[string]string{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &lookupFilter{filters: tt.filters}
+			if err := f.validate(attributes, queryFilters); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := f.queryParams(queryFilters); !reflect.DeepEqual(got, tt.params) {
+				t.Errorf("queryParams() = %v, want %v", got, tt.params)
+			}
+		})
+	}
+}
+
+func TestLookupFilterSelectCandidate(t *testing.T) {
+	candidates := []lookupCandidate{
+		{id: 1, name: "a", dateCreated: "2024-01-02T00:00:00Z"},
+		{id: 2, name: "b", dateCreated: "2024-03-01T00:00:00Z"},
+		{id: 3, name: "c", dateCreated: "2024-02-01T00:00:00Z"},
+	}
+	tests := []struct {
+		name       string
+		mostRecent bool
+		candidates []lookupCandidate
+		want       int
+		wantErr    bool
+	}{
+		{
+			name:       "Test case 1: no candidates",
+			candidates: nil,
+			wantErr:    true,
+		},
+		{
+			name:       "Test case 2: single candidate",
+			candidates: candidates[:1],
+			want:       0,
+		},
+		{
+			name:       "Test case 3: multiple candidates",
+			candidates: candidates,
+			wantErr:    true,
+		},
+		{
+			name:       "Test case 4: most recent",
+			mostRecent: true,
+			candidates: candidates,
+			want:       1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &lookupFilter{mostRecent: tt.mostRecent}
+			got, err := f.selectCandidate("plan", tt.candidates)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("selectCandidate() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("selectCandidate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,52 +3,33 @@
 package cmp
 
 import (
-	"context"
-	"fmt"
-	"log"
-
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 )
 
-type group struct {
-	gClient *client.GroupsAPIService
-}
-
-func newGroup(gClient *client.GroupsAPIService) *group {
-	return &group{
-		gClient: gClient,
+func groupCatalogue(api *cmpAPI) catalogue[models.Group] {
+	return catalogue[models.Group]{
+		api:     api,
+		kind:    "group",
+		path:    func(d *utils.Data) string { return "groups" },
+		listKey: "groups",
+		itemKey: "group",
+		item: func(g models.Group) (int, string, map[string]interface{}) {
+			return g.ID, g.Name, groupAttributes(g)
+		},
+		dateCreated: func(g models.Group) string { return g.DateCreated },
 	}
 }
 
-func (g *group) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, g.gClient.Client)
-	log.Printf("[DEBUG] Get Group")
-
-	name := d.GetString("name")
-	// Pre check
-	if err := d.Error(); err != nil {
-		return err
-	}
-	param := map[string]string{maxKey: "-1"}
-	groups, err := g.gClient.GetAllGroups(ctx, param)
-	if err != nil {
-		return err
+func newGroup(api *cmpAPI) *catalogueLookup[models.Group] {
+	return &catalogueLookup[models.Group]{
+		catalogue: groupCatalogue(api),
 	}
+}
 
-	isMatched := false
-	for i, g := range *groups.Groups {
-		if g.Name == name {
-			isMatched = true
-			d.SetID((*groups.Groups)[i].ID)
-
-			break
-		}
+func newGroupList(api *cmpAPI) *catalogueList[models.Group] {
+	return &catalogueList[models.Group]{
+		catalogue: groupCatalogue(api),
+		attribute: "groups",
 	}
-	if !isMatched {
-		return fmt.Errorf(errExactMatch, "group")
-	}
-
-	// post check
-	return d.Error()
 }
@@ -57,16 +57,23 @@ func GetCmpVersion(ctx context.Context, apiClient client.APIClientHandler) (int,
 	return ParseVersion(cmpVersion.Appliance.BuildVersion)
 }
 
+// GetNsxTypeFromCMP returns the display name of NSX-T network server of CMP
 func GetNsxTypeFromCMP(ctx context.Context, apiClient client.APIClientHandler) (string, error) {
-	cmpVersion, err := GetCmpVersion(ctx, apiClient)
+	capabilities, err := fetchCapabilities(ctx, apiClient)
 	if err != nil {
 		return "", err
 	}
-	if v, _ := ParseVersion("6.2.4"); v <= cmpVersion {
-		// from 6.2.4 onwards the display name of NSX-T has been change to NSX
 
-		return nsx, nil
+	return capabilities.nsxType(), nil
+}
+
+// getNsxType returns the display name of NSX-T network server, which depends
+// on the CMP version
+func (c *cache) getNsxType(ctx context.Context, apiClient client.APIClientHandler) (string, error) {
+	capabilities, err := c.getCapabilities(ctx, apiClient)
+	if err != nil {
+		return "", err
 	}
 
-	return nsxt, nil
+	return capabilities.nsxType(), nil
 }
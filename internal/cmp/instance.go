@@ -1,13 +1,15 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
 	"log"
+	"net/http"
 	"strings"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 )
@@ -18,11 +20,12 @@ type instance struct {
 	instanceSharedClient
 }
 
-func newInstance(iClient *client.InstancesAPIService, sClient *client.ServersAPIService) *instance {
+func newInstance(iClient *client.InstancesAPIService, sClient *client.ServersAPIService, api *cmpAPI) *instance {
 	return &instance{
 		instanceSharedClient{
 			iClient: iClient,
 			sClient: sClient,
+			api:     api,
 		},
 	}
 }
@@ -70,7 +73,7 @@ func (i *instance) Create(ctx context.Context, d *utils.Data, meta interface{})
 	}
 
 	// create instance
-	respVM, err := i.iClient.CreateAnInstance(ctx, req)
+	respVM, err := i.createInstance(ctx, req, d.GetListMap("network"))
 	if err != nil {
 		return err
 	}
@@ -102,10 +105,38 @@ func (i *instance) Create(ctx context.Context, d *utils.Data, meta interface{})
 	return d.Error()
 }
 
+// createInstance creates the instance. Create API of cmp-go-sdk does not
+// support static IP addresses of network interfaces, hence the request with
+// static IP addresses is sent using cmpAPI.
+func (i *instance) createInstance(
+	ctx context.Context,
+	req *models.CreateInstanceBody,
+	networks []map[string]interface{},
+) (models.GetInstanceResponse, error) {
+	ips := instanceGetStaticIPs(networks)
+	isStatic := false
+	for _, ip := range ips {
+		isStatic = isStatic || ip != ""
+	}
+	if !isStatic {
+		return i.iClient.CreateAnInstance(ctx, req)
+	}
+
+	var resp models.GetInstanceResponse
+	capabilities, err := i.api.cache.getCapabilities(ctx, i.iClient.Client)
+	if err != nil {
+		return resp, err
+	}
+	err = i.api.do(ctx, http.MethodPost, consts.InstancesPath, nil,
+		newInstanceCreateBody(req, ips, capabilities), &resp)
+
+	return resp, err
+}
+
 func (i *instance) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
 	setMeta(meta, i.iClient.Client)
 
-	return updateInstance(ctx, i.instanceSharedClient, d)
+	return updateInstance(ctx, i.instanceSharedClient, d, meta)
 }
 
 func (i *instance) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
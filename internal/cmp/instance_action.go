@@ -0,0 +1,307 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
+)
+
+// instanceAction implements one-shot actions on an existing instance
+type instanceAction struct {
+	instanceSharedClient
+}
+
+type instanceWorkflowBody struct {
+	TaskSet instanceWorkflowTaskSet `json:"taskSet"`
+}
+
+type instanceWorkflowTaskSet struct {
+	CustomOptions map[string]interface{} `json:"customOptions,omitempty"`
+}
+
+type instanceTaskBody struct {
+	Job instanceTaskJob `json:"job"`
+}
+
+type instanceTaskJob struct {
+	TargetType    string                 `json:"targetType"`
+	Instances     []int                  `json:"instances"`
+	CustomOptions map[string]interface{} `json:"customOptions,omitempty"`
+}
+
+// instanceTaskExecuteResp is the response of POST /api/tasks/:id/execute
+type instanceTaskExecuteResp struct {
+	models.SuccessOrErrorMessage
+	JobExecution instanceJobExecution `json:"jobExecution"`
+}
+
+type instanceJobExecutionResp struct {
+	JobExecution instanceJobExecution `json:"jobExecution"`
+}
+
+// instanceJobExecution is the job execution of a task, process is the
+// corresponding history entry if any
+type instanceJobExecution struct {
+	ID            int                                 `json:"id"`
+	Name          string                              `json:"name"`
+	Status        string                              `json:"status"`
+	StatusMessage string                              `json:"statusMessage"`
+	StartDate     string                              `json:"startDate"`
+	EndDate       string                              `json:"endDate"`
+	Process       *models.GetInstanceHistoryProcesses `json:"process"`
+}
+
+func newInstanceAction(iClient *client.InstancesAPIService, api *cmpAPI) *instanceAction {
+	return &instanceAction{
+		instanceSharedClient: instanceSharedClient{
+			iClient: iClient,
+			api:     api,
+		},
+	}
+}
+
+// Create runs the action, waits for it to complete and stores the
+// corresponding instance history entry. Task actions store the job execution
+// of the task.
+func (i *instanceAction) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, i.iClient.Client)
+	instanceID := d.GetInt("instance_id")
+	action := d.GetString("action")
+	// Pre check
+	if err := d.Error(); err != nil {
+		return err
+	}
+
+	lastProcessID, err := instanceGetLastProcessID(ctx, i.instanceSharedClient, instanceID)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Running action %s on instance %d", action, instanceID)
+	executionID, err := i.runAction(ctx, d, instanceID, action)
+	if err != nil {
+		return fmt.Errorf("failed to run action %s on instance %d: %w", action, instanceID, err)
+	}
+
+	// running a task does not necessarily create an instance history entry,
+	// hence the job execution of the task is waited for
+	var process *models.GetInstanceHistoryProcesses
+	if action == utils.ActionTask {
+		process, err = instanceWaitForJobExecution(ctx, i.api, meta, executionID)
+	} else {
+		process, err = instanceWaitForHistory(ctx, i.instanceSharedClient, meta, instanceID, lastProcessID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed while waiting for action %s on instance %d: %w", action, instanceID, err)
+	}
+	if action == utils.ActionRestart || action == utils.ActionReset {
+		if err := instanceWaitForStatus(ctx, i.instanceSharedClient, meta, instanceID, utils.StateRunning); err != nil {
+			return err
+		}
+	}
+
+	d.Set("history_id", process.ID)
+	d.SetString("status", process.Status)
+	d.SetString("process_type", process.ProcessType.Code)
+	d.SetString("display_name", process.DisplayName)
+	d.SetString("start_date", process.StartDate)
+	d.SetString("end_date", process.EndDate)
+	if action == utils.ActionTask {
+		d.SetID(strconv.Itoa(executionID))
+	} else {
+		d.SetID(strconv.Itoa(process.ID))
+	}
+
+	// post check
+	return d.Error()
+}
+
+// Read only verifies that the instance still exists. Actions are not
+// repeatable, so the recorded history entry is kept as is.
+func (i *instanceAction) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, i.iClient.Client)
+
+	_, err := i.iClient.GetASpecificInstance(ctx, d.GetInt("instance_id"))
+	if err != nil {
+		if statusCode := pkgUtils.GetStatusCode(err); statusCode == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Update is not supported, since all the attributes are ForceNew
+func (i *instanceAction) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return nil
+}
+
+// Delete only removes the action from the state
+func (i *instanceAction) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return nil
+}
+
+// runAction triggers the action. cmp-go-sdk supports only the power actions,
+// hence the rest of the actions use the following Morpheus instance APIs:
+//   - PUT /api/instances/:id/action?code=<code> (Execute an Action on an Instance),
+//     the action codes of an instance are listed by GET /api/instances/:id/actions
+//   - PUT /api/instances/:id/eject (Eject an Instance)
+//   - PUT /api/instances/:id/workflow?workflowId=<id> (Execute a Workflow on an Instance)
+//   - POST /api/tasks/:id/execute (Execute a Task)
+//
+// Returns the ID of the job execution for task actions, otherwise 0.
+func (i *instanceAction) runAction(ctx context.Context, d *utils.Data, instanceID int, action string) (int, error) {
+	var (
+		resp models.SuccessOrErrorMessage
+		err  error
+	)
+
+	switch action {
+	case utils.ActionRestart:
+		powerResp, err := i.iClient.RestartAnInstance(ctx, instanceID)
+		if err != nil {
+			return 0, err
+		}
+		resp.Success = powerResp.Success
+	case utils.ActionReset:
+		err = i.api.do(ctx, http.MethodPut, fmt.Sprintf("instances/%d/action", instanceID),
+			map[string]string{codeKey: instanceActionResetCode}, nil, &resp)
+	case utils.ActionRefreshAgent:
+		err = i.api.do(ctx, http.MethodPut, fmt.Sprintf("instances/%d/action", instanceID),
+			map[string]string{codeKey: instanceActionAgentCode}, nil, &resp)
+	case utils.ActionEject:
+		err = i.api.do(ctx, http.MethodPut, fmt.Sprintf("instances/%d/eject", instanceID), nil, nil, &resp)
+	case utils.ActionWorkflow:
+		workflowID := d.GetInt("workflow_id")
+		if workflowID == 0 {
+			return 0, fmt.Errorf("workflow_id is required for action %s", action)
+		}
+		err = i.api.do(ctx, http.MethodPut, fmt.Sprintf("instances/%d/workflow", instanceID),
+			map[string]string{instanceActionWorkflowKey: strconv.Itoa(workflowID)},
+			instanceWorkflowBody{
+				TaskSet: instanceWorkflowTaskSet{CustomOptions: d.GetMap("custom_options")},
+			}, &resp)
+	case utils.ActionTask:
+		taskID := d.GetInt("task_id")
+		if taskID == 0 {
+			return 0, fmt.Errorf("task_id is required for action %s", action)
+		}
+
+		return i.executeTask(ctx, d, instanceID, taskID)
+	default:
+		return 0, fmt.Errorf("unsupported action %s", action)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if !resp.Success {
+		return 0, fmt.Errorf(successErr, "running action "+action)
+	}
+
+	return 0, nil
+}
+
+// executeTask executes the task on the instance and returns the ID of the
+// job execution
+func (i *instanceAction) executeTask(ctx context.Context, d *utils.Data, instanceID, taskID int) (int, error) {
+	var resp instanceTaskExecuteResp
+	err := i.api.do(ctx, http.MethodPost, fmt.Sprintf("tasks/%d/execute", taskID), nil,
+		instanceTaskBody{
+			Job: instanceTaskJob{
+				TargetType:    instanceActionTargetType,
+				Instances:     []int{instanceID},
+				CustomOptions: d.GetMap("custom_options"),
+			},
+		}, &resp)
+	if err != nil {
+		return 0, err
+	}
+	if !resp.Success {
+		return 0, fmt.Errorf(successErr, "running action "+utils.ActionTask)
+	}
+	if resp.JobExecution.ID == 0 {
+		return 0, fmt.Errorf("job execution of task %d is not returned", taskID)
+	}
+
+	return resp.JobExecution.ID, nil
+}
+
+// instanceWaitForJobExecution waits for the job execution of a task to complete
+// and returns it as a history entry. ID of the history entry is 0 if the job
+// execution has no process.
+func instanceWaitForJobExecution(
+	ctx context.Context,
+	api *cmpAPI,
+	meta interface{},
+	executionID int,
+) (*models.GetInstanceHistoryProcesses, error) {
+	var execution instanceJobExecution
+	errCount := 0
+	executionRetry := utils.CustomRetry{
+		InitialDelay: time.Second * 5,
+		RetryDelay:   time.Second * 10,
+		Timeout:      maxTimeout,
+		Cond: func(response interface{}, ResponseErr error) (bool, error) {
+			if ResponseErr != nil {
+				errCount++
+				if errCount == 3 {
+					return false, ResponseErr
+				}
+
+				return false, nil
+			}
+			errCount = 0
+			execution = response.(instanceJobExecutionResp).JobExecution
+
+			return instanceJobExecutionDone(execution)
+		},
+	}
+	_, err := executionRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
+		var resp instanceJobExecutionResp
+		err := api.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", instanceJobExecutionsPath, executionID),
+			nil, nil, &resp)
+
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var process models.GetInstanceHistoryProcesses
+	if execution.Process != nil {
+		process = *execution.Process
+	}
+	process.Status = execution.Status
+	process.DisplayName = execution.Name
+	process.StartDate = execution.StartDate
+	process.EndDate = execution.EndDate
+
+	return &process, nil
+}
+
+// instanceJobExecutionDone returns true if the job execution is completed and
+// an error if it is failed
+func instanceJobExecutionDone(execution instanceJobExecution) (bool, error) {
+	switch execution.Status {
+	case "success", "complete":
+		return true, nil
+	case "failed", "error", "cancelled":
+		return false, fmt.Errorf("%s %s: %s", execution.Name, execution.Status, execution.StatusMessage)
+	}
+
+	return false, nil
+}
@@ -0,0 +1,137 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	apiClient "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testCmpAPI returns a cmpAPI which calls the server
+func testCmpAPI(server *httptest.Server) *cmpAPI {
+	cfg := apiClient.Configuration{Host: server.URL, HTTPClient: server.Client()}
+
+	return newCmpAPI(apiClient.NewAPIClient(&cfg), cfg, nil, 0, false)
+}
+
+func TestInstanceActionExecuteTask(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		response   string
+		want       int
+		wantErr    bool
+	}{
+		{
+			name:       "Test case 1: job execution of the task",
+			statusCode: http.StatusOK,
+			response:   `{"success":true,"jobExecution":{"id":12,"status":"running"}}`,
+			want:       12,
+		},
+		{
+			name:       "Test case 2: task is not executed",
+			statusCode: http.StatusOK,
+			response:   `{"success":false,"msg":"task not found"}`,
+			wantErr:    true,
+		},
+		{
+			name:       "Test case 3: job execution is not returned",
+			statusCode: http.StatusOK,
+			response:   `{"success":true}`,
+			wantErr:    true,
+		},
+		{
+			name:       "Test case 4: API error",
+			statusCode: http.StatusInternalServerError,
+			response:   `{"success":false}`,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotBody instanceTaskBody
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/api/tasks/5/execute" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("invalid request body: %v", err)
+				}
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			d := utils.NewData(schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+				"custom_options": {Type: schema.TypeMap, Optional: true},
+			}, map[string]interface{}{
+				"custom_options": map[string]interface{}{"env": "dev"},
+			}))
+			a := &instanceAction{instanceSharedClient: instanceSharedClient{api: testCmpAPI(server)}}
+			got, err := a.executeTask(context.Background(), d, 3, 5)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("executeTask() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("executeTask() = %v, want %v", got, tt.want)
+			}
+			wantBody := instanceTaskBody{Job: instanceTaskJob{
+				TargetType:    instanceActionTargetType,
+				Instances:     []int{3},
+				CustomOptions: map[string]interface{}{"env": "dev"},
+			}}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("request = %+v, want %+v", gotBody, wantBody)
+			}
+		})
+	}
+}
+
+func TestInstanceJobExecutionDone(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  string
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:   "Test case 1: running",
+			status: "running",
+			want:   false,
+		},
+		{
+			name:   "Test case 2: complete",
+			status: "complete",
+			want:   true,
+		},
+		{
+			name:    "Test case 3: failed",
+			status:  "failed",
+			wantErr: true,
+		},
+		{
+			name:    "Test case 4: cancelled",
+			status:  "cancelled",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := instanceJobExecutionDone(instanceJobExecution{Name: "task", Status: tt.status})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("instanceJobExecutionDone() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("instanceJobExecutionDone() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
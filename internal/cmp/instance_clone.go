@@ -1,4 +1,4 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
@@ -8,29 +8,50 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 )
 
 const (
 	instanceCloneRetryDelay = time.Second * 15
+	instanceProcessPath     = "processes"
 )
 
+type instanceCloneSnapshotBody struct {
+	models.CreateInstanceCloneBody
+	SnapshotID int `json:"snapshotId,omitempty"`
+}
+
+type instanceCloneProcess struct {
+	Process struct {
+		Events []struct {
+			InstanceID int `json:"instanceId"`
+		} `json:"events"`
+	} `json:"process"`
+}
+
 // instanceClone implements functions related to cmp instanceClones
 type instanceClone struct {
 	// expose Instance API service to instanceClones related operations
 	instanceSharedClient
 }
 
-func newInstanceClone(iClient *client.InstancesAPIService, sClient *client.ServersAPIService) *instanceClone {
+func newInstanceClone(
+	iClient *client.InstancesAPIService,
+	sClient *client.ServersAPIService,
+	api *cmpAPI,
+) *instanceClone {
 	return &instanceClone{
 		instanceSharedClient: instanceSharedClient{
 			iClient: iClient,
 			sClient: sClient,
+			api:     api,
 		},
 	}
 }
@@ -76,53 +97,52 @@ func (i *instanceClone) Create(ctx context.Context, d *utils.Data, meta interfac
 		return err
 	}
 
-	// clone the instance
-	log.Printf("[INFO] Cloning the instance with %d", sourceID)
-	err = cloneInstance(ctx, i, meta, req, sourceID)
+	snapshotID := d.GetInt("source_snapshot_id")
+	if snapshotID != 0 {
+		if err := instanceCloneCheckSnapshot(ctx, i, sourceID, snapshotID); err != nil {
+			return err
+		}
+	}
+
+	// instances with the same name and the latest process of the source instance
+	// are recorded before cloning, so that only the new clone is considered later
+	existingIDs, err := instanceCloneGetIDsByName(ctx, i, req.Name)
 	if err != nil {
 		return err
 	}
-
-	log.Printf("[INFO] Check history")
-	err = checkInstanceCloneHistory(ctx, i, meta, sourceID)
+	lastProcessID, err := instanceGetLastProcessID(ctx, i.instanceSharedClient, sourceID)
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Get all instances")
-	getInstanceRetry := &utils.CustomRetry{
-		RetryDelay: instanceCloneRetryDelay,
-		Timeout:    time.Minute * 2,
-		Cond: func(resp interface{}, err error) (bool, error) {
-			if err != nil {
-				return false, nil
-			}
-			instancesList := resp.(models.Instances)
-
-			return len(instancesList.Instances) == 1, nil
-		},
-	}
-	// get cloned instance ID
-	instancesResp, err := getInstanceRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
-		return i.iClient.GetAllInstances(ctx, map[string]string{
-			nameKey: req.Name,
-		})
-	})
+	// clone the instance
+	log.Printf("[INFO] Cloning the instance with %d", sourceID)
+	cloneID, err := cloneInstance(ctx, i, meta, req, sourceID, snapshotID)
 	if err != nil {
 		return err
 	}
 
-	instancesList := instancesResp.(models.Instances)
-	if len(instancesList.Instances) != 1 {
-		return errors.New("get cloned instance is failed")
+	// clone ID is looked up from the history of the source instance, if not
+	// returned by the clone API
+	if cloneID == 0 {
+		log.Printf("[INFO] Check history")
+		cloneProcess, err := checkInstanceCloneHistory(ctx, i, meta, sourceID, lastProcessID, req.Name)
+		if err != nil {
+			return err
+		}
+
+		cloneID, err = instanceCloneGetID(ctx, i, meta, cloneProcess, sourceID, req.Name, existingIDs)
+		if err != nil {
+			return err
+		}
 	}
 
-	if err := instanceWaitUntilCreated(ctx, i.instanceSharedClient, meta, instancesList.Instances[0].ID); err != nil {
+	if err := instanceWaitUntilCreated(ctx, i.instanceSharedClient, meta, cloneID); err != nil {
 		return err
 	}
 
 	if snapshot := d.GetListMap("snapshot"); len(snapshot) == 1 {
-		err := createInstanceSnapshot(ctx, i.instanceSharedClient, instancesList.Instances[0].ID, models.SnapshotBody{
+		err := createInstanceSnapshot(ctx, i.instanceSharedClient, cloneID, models.SnapshotBody{
 			Snapshot: &models.SnapshotBodySnapshot{
 				Name:        snapshot[0]["name"].(string),
 				Description: snapshot[0]["description"].(string),
@@ -132,11 +152,11 @@ func (i *instanceClone) Create(ctx context.Context, d *utils.Data, meta interfac
 			return err
 		}
 	}
-	err = instanceSetServerID(ctx, d, i.instanceSharedClient)
+	err = instanceSetServerIDFromInstance(ctx, d, i.instanceSharedClient, cloneID)
 	if err != nil {
 		return err
 	}
-	d.SetID(instancesList.Instances[0].ID)
+	d.SetID(cloneID)
 
 	// post check
 	return d.Error()
@@ -148,7 +168,7 @@ func (i *instanceClone) Create(ctx context.Context, d *utils.Data, meta interfac
 func (i *instanceClone) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
 	setMeta(meta, i.iClient.Client)
 
-	return updateInstance(ctx, i.instanceSharedClient, d)
+	return updateInstance(ctx, i.instanceSharedClient, d, meta)
 }
 
 // Delete instance and set ID as ""
@@ -165,7 +185,18 @@ func (i *instanceClone) Read(ctx context.Context, d *utils.Data, meta interface{
 	return readInstance(ctx, i.instanceSharedClient, d, meta, true)
 }
 
-func checkInstanceCloneHistory(ctx context.Context, i *instanceClone, meta interface{}, instanceID int) error {
+// checkInstanceCloneHistory waits for the cloning process of the clone named name,
+// which is created after lastProcessID on the source instance, to complete and
+// returns the process.
+func checkInstanceCloneHistory(
+	ctx context.Context,
+	i *instanceClone,
+	meta interface{},
+	instanceID int,
+	lastProcessID int,
+	name string,
+) (models.GetInstanceHistoryProcesses, error) {
+	var cloneProcess models.GetInstanceHistoryProcesses
 	errCount := 0
 	historyRetry := utils.CustomRetry{
 		InitialDelay: time.Second * 15,
@@ -183,17 +214,16 @@ func checkInstanceCloneHistory(ctx context.Context, i *instanceClone, meta inter
 			errCount = 0
 
 			instanceHistory := response.(models.GetInstanceHistory)
-			for _, processes := range instanceHistory.Processes {
-				if processes.ProcessType.Code == "cloning" {
-					if processes.Status == "success" || processes.Status == "complete" {
-						return true, nil
-					}
-					if processes.Status == "failed" {
-						return false, fmt.Errorf("failed to clone instance")
-					}
-
-					break
-				}
+			process, ok := instanceCloneFindProcess(instanceHistory.Processes, lastProcessID, name)
+			if !ok {
+				return false, nil
+			}
+			cloneProcess = process
+			if process.Status == "success" || process.Status == "complete" {
+				return true, nil
+			}
+			if process.Status == "failed" {
+				return false, fmt.Errorf("failed to clone instance")
 			}
 
 			return false, nil
@@ -203,16 +233,47 @@ func checkInstanceCloneHistory(ctx context.Context, i *instanceClone, meta inter
 		return i.iClient.GetInstanceHistory(ctx, instanceID)
 	})
 
-	return err
+	return cloneProcess, err
 }
 
+// instanceCloneFindProcess returns the cloning process created after lastProcessID.
+// The process with the clone name as display name is preferred, since concurrent
+// clones of the same source create a process each.
+func instanceCloneFindProcess(
+	processes []models.GetInstanceHistoryProcesses,
+	lastProcessID int,
+	name string,
+) (models.GetInstanceHistoryProcesses, bool) {
+	var (
+		first models.GetInstanceHistoryProcesses
+		found bool
+	)
+	for _, process := range processes {
+		if process.ID <= lastProcessID || process.ProcessType.Code != "cloning" {
+			continue
+		}
+		if process.DisplayName == name {
+			return process, true
+		}
+		if !found || process.ID < first.ID {
+			first = process
+			found = true
+		}
+	}
+
+	return first, found
+}
+
+// cloneInstance clones the source instance and returns the ID of the clone if
+// it is returned by CMP, otherwise 0
 func cloneInstance(
 	ctx context.Context,
 	i *instanceClone,
 	meta interface{},
 	req models.CreateInstanceCloneBody,
 	sourceID int,
-) error {
+	snapshotID int,
+) (int, error) {
 	cloneRetry := &utils.CustomRetry{
 		Cond: func(response interface{}, ResponseErr error) (bool, error) {
 			if ResponseErr != nil {
@@ -225,17 +286,157 @@ func cloneInstance(
 			return true, nil
 		},
 	}
-	_, err := cloneRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
+	resp, err := cloneRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
 		val, err := json.Marshal(&req)
 		if err != nil {
 			return nil, err
 		}
 		log.Printf("value: %s", string(val))
 
+		if snapshotID != 0 {
+			return cloneInstanceFromSnapshot(ctx, i, req, sourceID, snapshotID)
+		}
+
 		return i.iClient.CloneAnInstance(ctx, sourceID, req)
 	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.(models.SuccessOrErrorMessage).ID, nil
+}
+
+// cloneInstanceFromSnapshot clones the source instance from the given snapshot.
+// Clone API of cmp-go-sdk does not support snapshot, hence the request body
+// is converted here in the same way as cmp-go-sdk does.
+func cloneInstanceFromSnapshot(
+	ctx context.Context,
+	i *instanceClone,
+	req models.CreateInstanceCloneBody,
+	sourceID int,
+	snapshotID int,
+) (models.SuccessOrErrorMessage, error) {
+	var resp models.SuccessOrErrorMessage
+	capabilities, err := i.api.cache.getCapabilities(ctx, i.iClient.Client)
+	if err != nil {
+		return resp, err
+	}
+	if capabilities.Supports(CapInstanceLabels) {
+		req.Tags = req.Metadata
+		req.Metadata = nil
+		req.Instance.Labels = req.Instance.Tags
+		req.Instance.Tags = nil
+	}
+	err = i.api.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d/clone", consts.InstancesPath, sourceID), nil,
+		instanceCloneSnapshotBody{
+			CreateInstanceCloneBody: req,
+			SnapshotID:              snapshotID,
+		}, &resp)
+
+	return resp, err
+}
+
+func instanceCloneCheckSnapshot(ctx context.Context, i *instanceClone, sourceID, snapshotID int) error {
+	snapshots, err := i.iClient.GetListOfSnapshotsForAnInstance(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+	for _, s := range snapshots.Snapshots {
+		if s.ID == snapshotID {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("snapshot %d is not found on source instance %d", snapshotID, sourceID)
+}
+
+func instanceCloneGetIDsByName(ctx context.Context, i *instanceClone, name string) (map[int]bool, error) {
+	instancesList, err := i.iClient.GetAllInstances(ctx, map[string]string{
+		nameKey: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[int]bool, len(instancesList.Instances))
+	for _, instance := range instancesList.Instances {
+		ids[instance.ID] = true
+	}
+
+	return ids, nil
+}
+
+// instanceCloneGetID returns ID of the cloned instance. The instance is taken from
+// the events of the cloning process, if it has the clone name. Otherwise, instances
+// with the clone name which are created after the clone request are considered.
+func instanceCloneGetID(
+	ctx context.Context,
+	i *instanceClone,
+	meta interface{},
+	cloneProcess models.GetInstanceHistoryProcesses,
+	sourceID int,
+	name string,
+	existingIDs map[int]bool,
+) (int, error) {
+	var process instanceCloneProcess
+	err := i.api.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", instanceProcessPath, cloneProcess.ID),
+		nil, nil, &process)
+	if err != nil {
+		log.Printf("[WARN] Failed to get cloning process %d: %v", cloneProcess.ID, err)
+	}
+	for _, event := range process.Process.Events {
+		if event.InstanceID == 0 || event.InstanceID == sourceID {
+			continue
+		}
+		// the process may belong to a concurrent clone of the source
+		instance, err := i.iClient.GetASpecificInstance(ctx, event.InstanceID)
+		if err != nil {
+			log.Printf("[WARN] Failed to get instance %d of cloning process %d: %v", event.InstanceID,
+				cloneProcess.ID, err)
+
+			break
+		}
+		if instance.Instance.Name == name {
+			log.Printf("[INFO] Found cloned instance %d from cloning process %d", event.InstanceID, cloneProcess.ID)
+
+			return event.InstanceID, nil
+		}
+	}
+
+	log.Printf("[INFO] Get all instances")
+	getInstanceRetry := &utils.CustomRetry{
+		RetryDelay: instanceCloneRetryDelay,
+		Timeout:    time.Minute * 2,
+		Cond: func(resp interface{}, err error) (bool, error) {
+			if err != nil {
+				return false, nil
+			}
+
+			return len(resp.([]int)) == 1, nil
+		},
+	}
+	resp, err := getInstanceRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
+		ids, err := instanceCloneGetIDsByName(ctx, i, name)
+		if err != nil {
+			return nil, err
+		}
+		newIDs := make([]int, 0, 1)
+		for id := range ids {
+			if !existingIDs[id] {
+				newIDs = append(newIDs, id)
+			}
+		}
+
+		return newIDs, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	newIDs := resp.([]int)
+	if len(newIDs) != 1 {
+		return 0, errors.New("get cloned instance is failed")
+	}
 
-	return err
+	return newIDs[0], nil
 }
 
 func copyInstanceAttribsToClone(
@@ -0,0 +1,61 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"testing"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+)
+
+func testCloneProcess(id int, code, displayName string) models.GetInstanceHistoryProcesses {
+	return models.GetInstanceHistoryProcesses{
+		ID:          id,
+		ProcessType: models.GetInstanceHistoryProcessType{Code: code},
+		DisplayName: displayName,
+	}
+}
+
+func TestInstanceCloneFindProcess(t *testing.T) {
+	tests := []struct {
+		name      string
+		processes []models.GetInstanceHistoryProcesses
+		wantID    int
+		wantOk    bool
+	}{
+		{
+			name: "Test case 1: process with clone name",
+			processes: []models.GetInstanceHistoryProcesses{
+				testCloneProcess(12, "cloning", "clone-2"),
+				testCloneProcess(11, "cloning", "clone-1"),
+			},
+			wantID: 11,
+			wantOk: true,
+		},
+		{
+			name: "Test case 2: first new process without clone name",
+			processes: []models.GetInstanceHistoryProcesses{
+				testCloneProcess(12, "cloning", "source"),
+				testCloneProcess(11, "cloning", "source"),
+			},
+			wantID: 11,
+			wantOk: true,
+		},
+		{
+			name: "Test case 3: old and other processes",
+			processes: []models.GetInstanceHistoryProcesses{
+				testCloneProcess(11, "resize", "clone-1"),
+				testCloneProcess(10, "cloning", "clone-1"),
+			},
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := instanceCloneFindProcess(tt.processes, 10, "clone-1")
+			if ok != tt.wantOk || got.ID != tt.wantID {
+				t.Errorf("instanceCloneFindProcess() = %v, %v, want %v, %v", got.ID, ok, tt.wantID, tt.wantOk)
+			}
+		})
+	}
+}
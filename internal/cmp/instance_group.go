@@ -0,0 +1,527 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+)
+
+// instanceGroup implements a fleet of identical instances created from a
+// single template. Members are named using name_pattern and a 1 based index.
+type instanceGroup struct {
+	instanceSharedClient
+}
+
+// instanceGroupMember is the state of a single member of the instance group
+type instanceGroupMember struct {
+	Index       int
+	ID          int
+	Name        string
+	ServerID    int
+	Status      string
+	IPAddresses []string
+}
+
+func newInstanceGroup(iClient *client.InstancesAPIService, sClient *client.ServersAPIService) *instanceGroup {
+	return &instanceGroup{
+		instanceSharedClient: instanceSharedClient{
+			iClient: iClient,
+			sClient: sClient,
+		},
+	}
+}
+
+// Create creates instance_count members of the instance group
+func (g *instanceGroup) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, g.iClient.Client)
+	log.Printf("[INFO] Creating instance group")
+
+	d.SetID(id.UniqueId())
+	members, err := g.scale(ctx, d, meta, nil)
+	if setErr := instanceGroupSetMembers(d, members); setErr != nil {
+		return setErr
+	}
+	if err != nil {
+		return err
+	}
+
+	// post check
+	return d.Error()
+}
+
+// Read refreshes all the members. Members which are deleted outside of terraform
+// are removed from the state, so that those will be recreated on next apply.
+func (g *instanceGroup) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, g.iClient.Client)
+
+	members := instanceGroupGetMembers(d)
+	refreshed := make([]instanceGroupMember, 0, len(members))
+	for _, m := range members {
+		resp, err := g.iClient.GetASpecificInstance(ctx, m.ID)
+		if err != nil {
+			if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+				log.Printf("[WARN] Member %s of instance group is not found", m.Name)
+
+				continue
+			}
+
+			return err
+		}
+		refreshed = append(refreshed, instanceGroupMemberFromResponse(m.Index, resp))
+	}
+
+	return instanceGroupSetMembers(d, refreshed)
+}
+
+// Update scales the instance group. New members are created for missing indexes
+// and members with the highest indexes are removed first while scaling down.
+// Changes in template are applied only on the newly created members.
+func (g *instanceGroup) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, g.iClient.Client)
+
+	members, err := g.scale(ctx, d, meta, instanceGroupGetMembers(d))
+	if setErr := instanceGroupSetMembers(d, members); setErr != nil {
+		return setErr
+	}
+
+	return err
+}
+
+// Delete removes all the members of the instance group
+func (g *instanceGroup) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, g.iClient.Client)
+
+	members := instanceGroupGetMembers(d)
+	remaining, err := g.deleteMembers(ctx, d, meta, members)
+	if err != nil {
+		if setErr := instanceGroupSetMembers(d, remaining); setErr != nil {
+			return setErr
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// scale creates missing members with index 1 to instance_count and deletes members
+// with greater index. Returns the members which exists after scaling.
+func (g *instanceGroup) scale(
+	ctx context.Context,
+	d *utils.Data,
+	meta interface{},
+	members []instanceGroupMember,
+) ([]instanceGroupMember, error) {
+	count := d.GetInt("instance_count")
+	existing := make(map[int]bool, len(members))
+	toDelete := make([]instanceGroupMember, 0)
+	for _, m := range members {
+		if m.Index > count {
+			toDelete = append(toDelete, m)
+		} else {
+			existing[m.Index] = true
+		}
+	}
+
+	remaining, err := g.deleteMembers(ctx, d, meta, toDelete)
+	kept := make([]instanceGroupMember, 0, count)
+	for _, m := range members {
+		if m.Index <= count {
+			kept = append(kept, m)
+		}
+	}
+	kept = append(kept, remaining...)
+	if err != nil {
+		return kept, err
+	}
+
+	toCreate := make([]int, 0, count)
+	for i := 1; i <= count; i++ {
+		if !existing[i] {
+			toCreate = append(toCreate, i)
+		}
+	}
+	created, err := g.createMembers(ctx, d, meta, toCreate)
+	kept = append(kept, created...)
+	sort.Slice(kept, func(i, j int) bool {
+		return kept[i].Index < kept[j].Index
+	})
+
+	return kept, err
+}
+
+// createMembers creates members with the given indexes. At most max_parallel
+// create requests are in progress at a time and all created members are polled
+// together until they are provisioned.
+func (g *instanceGroup) createMembers(
+	ctx context.Context,
+	d *utils.Data,
+	meta interface{},
+	indexes []int,
+) ([]instanceGroupMember, error) {
+	if len(indexes) == 0 {
+		return nil, nil
+	}
+	templates := d.GetListMap("template")
+	if len(templates) != 1 {
+		return nil, fmt.Errorf("template is required for instance group")
+	}
+	template := templates[0]
+	namePattern := d.GetString("name_pattern")
+	// Pre check
+	if err := d.Error(); err != nil {
+		return nil, err
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		created = make([]instanceGroupMember, 0, len(indexes))
+		errs    = make([]string, 0)
+		sem     = make(chan struct{}, instanceGroupParallel(d))
+	)
+	for _, index := range indexes {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			name := instanceGroupMemberName(namePattern, index)
+			log.Printf("[INFO] Creating instance group member %s", name)
+			resp, err := g.iClient.CreateAnInstance(ctx, instanceGroupCreateBody(template, name))
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %v", name, err))
+
+				return
+			}
+			created = append(created, instanceGroupMember{
+				Index: index,
+				ID:    resp.Instance.ID,
+				Name:  name,
+			})
+		}(index)
+	}
+	wg.Wait()
+
+	members, err := g.waitForMembers(ctx, meta, created)
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+	if len(errs) > 0 {
+		return members, fmt.Errorf("failed to create instance group members: %s", strings.Join(errs, "; "))
+	}
+
+	return members, nil
+}
+
+// waitForMembers polls all the members together until all of them are either
+// running or failed. Returns the refreshed members.
+func (g *instanceGroup) waitForMembers(
+	ctx context.Context,
+	meta interface{},
+	members []instanceGroupMember,
+) ([]instanceGroupMember, error) {
+	if len(members) == 0 {
+		return members, nil
+	}
+	refreshed := make([]instanceGroupMember, len(members))
+	copy(refreshed, members)
+	pending := make(map[int]bool, len(members))
+	for i := range members {
+		pending[i] = true
+	}
+
+	errCount := 0
+	cRetry := utils.CustomRetry{
+		Timeout:      maxTimeout,
+		RetryDelay:   time.Second * 15,
+		InitialDelay: time.Second * 30,
+		Cond: func(response interface{}, err error) (bool, error) {
+			if err != nil {
+				errCount++
+				if errCount == 3 {
+					return false, err
+				}
+
+				return false, nil
+			}
+			errCount = 0
+
+			return len(pending) == 0, nil
+		},
+	}
+	_, err := cRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
+		for i := range pending {
+			resp, err := g.iClient.GetASpecificInstance(ctx, refreshed[i].ID)
+			if err != nil {
+				return nil, err
+			}
+			refreshed[i] = instanceGroupMemberFromResponse(refreshed[i].Index, resp)
+			if resp.Instance.Status == utils.StateRunning || resp.Instance.Status == utils.StateFailed {
+				delete(pending, i)
+			}
+		}
+
+		return nil, nil
+	})
+	if err != nil {
+		return refreshed, err
+	}
+
+	failed := make([]string, 0)
+	for _, m := range refreshed {
+		if m.Status == utils.StateFailed {
+			failed = append(failed, m.Name)
+		}
+	}
+	if len(failed) > 0 {
+		return refreshed, fmt.Errorf("instances %s went to %s state", strings.Join(failed, ", "), utils.StateFailed)
+	}
+
+	return refreshed, nil
+}
+
+// deleteMembers deletes the given members, starting from the highest index, and
+// returns the members which are not deleted.
+func (g *instanceGroup) deleteMembers(
+	ctx context.Context,
+	d *utils.Data,
+	meta interface{},
+	members []instanceGroupMember,
+) ([]instanceGroupMember, error) {
+	if len(members) == 0 {
+		return nil, nil
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Index > members[j].Index
+	})
+
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		remaining = make([]instanceGroupMember, 0)
+		errs      = make([]string, 0)
+		sem       = make(chan struct{}, instanceGroupParallel(d))
+	)
+	for _, m := range members {
+		// acquire before starting the routine, so that deletes are started
+		// in descending order of index
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(m instanceGroupMember) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			log.Printf("[INFO] Deleting instance group member %s", m.Name)
+			err := g.deleteMember(ctx, meta, m.ID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				remaining = append(remaining, m)
+				errs = append(errs, fmt.Sprintf("%s: %v", m.Name, err))
+			}
+		}(m)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return remaining, fmt.Errorf("failed to delete instance group members: %s", strings.Join(errs, "; "))
+	}
+
+	return nil, nil
+}
+
+func (g *instanceGroup) deleteMember(ctx context.Context, meta interface{}, instanceID int) error {
+	resp, err := g.iClient.DeleteAnInstance(ctx, instanceID)
+	if err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			return nil
+		}
+
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("failed to delete instance with error: %s", resp.Message)
+	}
+
+	errCount := 0
+	cRetry := utils.CustomRetry{
+		RetryDelay: time.Second * 15,
+		Timeout:    maxTimeout,
+		Cond: func(response interface{}, ResponseErr error) (bool, error) {
+			if ResponseErr != nil {
+				if pkgUtils.GetStatusCode(ResponseErr) == http.StatusNotFound {
+					return true, nil
+				}
+				errCount++
+				if errCount == 3 {
+					return false, ResponseErr
+				}
+			}
+
+			return false, nil
+		},
+	}
+	_, err = cRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
+		return g.iClient.GetASpecificInstance(ctx, instanceID)
+	})
+
+	return err
+}
+
+func instanceGroupParallel(d *utils.Data) int {
+	if p := d.GetInt("max_parallel"); p > 0 {
+		return p
+	}
+
+	return 1
+}
+
+// instanceGroupMemberName returns member name by replacing %d style verb in
+// name pattern with index. If pattern has no verb, index is appended.
+func instanceGroupMemberName(pattern string, index int) string {
+	if strings.Contains(pattern, "%") {
+		return fmt.Sprintf(pattern, index)
+	}
+
+	return fmt.Sprintf("%s-%d", pattern, index)
+}
+
+func instanceGroupCreateBody(t map[string]interface{}, name string) *models.CreateInstanceBody {
+	labels := make([]string, 0)
+	if l, ok := t["labels"].([]interface{}); ok {
+		for _, v := range l {
+			labels = append(labels, v.(string))
+		}
+	}
+	tags, _ := t["tags"].(map[string]interface{})
+	evars, _ := t["evars"].(map[string]interface{})
+	instanceTypeCode := t["instance_type_code"].(string)
+	var config *models.CreateInstanceBodyConfig
+	if c := utils.GetlistMap(t["config"]); len(c) > 0 {
+		config = instanceGetConfig(c[0], strings.ToLower(instanceTypeCode) == vmware)
+	}
+
+	return &models.CreateInstanceBody{
+		ZoneID: json.Number(strconv.Itoa(t["cloud_id"].(int))),
+		Instance: &models.CreateInstanceBodyInstance{
+			Name: name,
+			InstanceType: &models.CreateInstanceBodyInstanceInstanceType{
+				Code: instanceTypeCode,
+			},
+			Plan: &models.CreateInstanceBodyInstancePlan{
+				ID: json.Number(strconv.Itoa(t["plan_id"].(int))),
+			},
+			Site: &models.CreateInstanceBodyInstanceSite{
+				ID: t["group_id"].(int),
+			},
+			Layout: &models.CreateInstanceBodyInstanceLayout{
+				ID: json.Number(strconv.Itoa(t["layout_id"].(int))),
+			},
+			EnvironmentPrefix: t["env_prefix"].(string),
+		},
+		Environment:       t["environment_code"].(string),
+		Evars:             instanceGetEvars(evars),
+		Labels:            labels,
+		Volumes:           instanceGetVolume(utils.GetlistMap(t["volume"])),
+		NetworkInterfaces: instanceGetNetwork(utils.GetlistMap(t["network"])),
+		Config:            config,
+		Tags:              instanceGetTags(tags),
+		PowerScheduleType: instanceGroupJSONNumber(t["power_schedule_id"].(int)),
+	}
+}
+
+func instanceGroupJSONNumber(v int) json.Number {
+	if v == 0 {
+		return ""
+	}
+
+	return json.Number(strconv.Itoa(v))
+}
+
+func instanceGroupMemberFromResponse(index int, resp models.GetInstanceResponse) instanceGroupMember {
+	m := instanceGroupMember{
+		Index:       index,
+		ID:          resp.Instance.ID,
+		Name:        resp.Instance.Name,
+		Status:      resp.Instance.Status,
+		IPAddresses: make([]string, 0, len(resp.Instance.ConnectionInfo)),
+	}
+	for _, c := range resp.Instance.ConnectionInfo {
+		if c.IP != "" {
+			m.IPAddresses = append(m.IPAddresses, c.IP)
+		}
+	}
+	if servers, ok := resp.Instance.Servers.([]interface{}); ok && len(servers) > 0 {
+		if serverID, ok := servers[0].(float64); ok {
+			m.ServerID = int(serverID)
+		}
+	}
+
+	return m
+}
+
+// instanceGroupGetMembers returns the members from the state. Prior value is
+// used, since member is unknown in the plan while scaling.
+func instanceGroupGetMembers(d *utils.Data) []instanceGroupMember {
+	memberMaps, _ := d.GetChangedListMap("member")
+	members := make([]instanceGroupMember, 0, len(memberMaps))
+	for _, m := range memberMaps {
+		member := instanceGroupMember{
+			Index:    m["index"].(int),
+			ID:       m["id"].(int),
+			Name:     m["name"].(string),
+			ServerID: m["server_id"].(int),
+			Status:   m["status"].(string),
+		}
+		if ips, ok := m["ip_addresses"].([]interface{}); ok {
+			for _, ip := range ips {
+				member.IPAddresses = append(member.IPAddresses, ip.(string))
+			}
+		}
+		members = append(members, member)
+	}
+
+	return members
+}
+
+func instanceGroupSetMembers(d *utils.Data, members []instanceGroupMember) error {
+	memberMaps := make([]map[string]interface{}, 0, len(members))
+	ids := make([]int, 0, len(members))
+	for _, m := range members {
+		memberMaps = append(memberMaps, map[string]interface{}{
+			"index":        m.Index,
+			"id":           m.ID,
+			"name":         m.Name,
+			"server_id":    m.ServerID,
+			"status":       m.Status,
+			"ip_addresses": m.IPAddresses,
+		})
+		ids = append(ids, m.ID)
+	}
+	if err := d.Set("member", memberMaps); err != nil {
+		return err
+	}
+
+	return d.Set("instance_ids", ids)
+}
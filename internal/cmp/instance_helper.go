@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
@@ -20,6 +21,7 @@ import (
 type instanceSharedClient struct {
 	iClient *client.InstancesAPIService
 	sClient *client.ServersAPIService
+	api     *cmpAPI
 }
 
 func readInstance(ctx context.Context, sharedClient instanceSharedClient, d *utils.Data, meta interface{}, isClone bool) error {
@@ -40,6 +42,7 @@ func readInstance(ctx context.Context, sharedClient instanceSharedClient, d *uti
 	if err := tftags.Get(d, &tfInstance); err != nil {
 		return err
 	}
+	staticIPs := instanceGetStaticIPs(d.GetListMap("network"))
 
 	// Read and update the volume
 	tfInstance.Volume = instanceSetVolume(instance.Instance.Volumes)
@@ -85,6 +88,10 @@ func readInstance(ctx context.Context, sharedClient instanceSharedClient, d *uti
 	if err != nil {
 		return err
 	}
+	err = instanceSetStaticIPs(d, staticIPs)
+	if err != nil {
+		return err
+	}
 
 	d.SetID(instance.Instance.ID)
 
@@ -95,7 +102,7 @@ func readInstance(ctx context.Context, sharedClient instanceSharedClient, d *uti
 // Update instance including poweroff, powerOn, restart, suspend
 // changing volumes and instance properties such as labels
 // groups and tags
-func updateInstance(ctx context.Context, sharedClient instanceSharedClient, d *utils.Data) error {
+func updateInstance(ctx context.Context, sharedClient instanceSharedClient, d *utils.Data, meta interface{}) error {
 	log.Printf("[DEBUG] Updating the instance")
 
 	id := d.GetID()
@@ -126,7 +133,7 @@ func updateInstance(ctx context.Context, sharedClient instanceSharedClient, d *u
 			return err
 		}
 	}
-	if err := instanceUpdateNetworkVolumePlan(ctx, sharedClient, d, id); err != nil {
+	if err := instanceUpdateNetworkVolumePlan(ctx, sharedClient, d, meta, id); err != nil {
 		return err
 	}
 
@@ -171,12 +178,36 @@ func deleteInstance(ctx context.Context, sharedClient instanceSharedClient, d *u
 	id := d.GetID()
 	log.Printf("[DEBUG] Deleting instance with ID : %d", id)
 
+	if d.GetBool("deletion_protection") {
+		return fmt.Errorf("instance %d has deletion_protection enabled. Set deletion_protection "+
+			"to false and apply before destroying the instance", id)
+	}
+	preserveVolumes := d.GetBool("preserve_volumes")
+	removeBackups := d.GetBool("remove_backups")
+	releaseIPs := d.GetBool("release_ips")
+	force := d.GetBool("force")
 	// Precheck
 	if err := d.Error(); err != nil {
 		return err
 	}
 
-	deleResp, err := sharedClient.iClient.DeleteAnInstance(ctx, id)
+	var (
+		deleResp models.SuccessOrErrorMessage
+		err      error
+	)
+	// sdk does not support the delete options. Default options are the same as
+	// the defaults of CMP, so sdk is used unless an option is changed.
+	if !preserveVolumes && removeBackups && releaseIPs && !force {
+		deleResp, err = sharedClient.iClient.DeleteAnInstance(ctx, id)
+	} else {
+		err = sharedClient.api.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", consts.InstancesPath, id),
+			map[string]string{
+				instanceDeletePreserveVolumesKey: instanceDeleteOption(preserveVolumes),
+				instanceDeleteKeepBackupsKey:     instanceDeleteOption(!removeBackups),
+				instanceDeleteReleaseIPsKey:      instanceDeleteOption(releaseIPs),
+				instanceDeleteForceKey:           instanceDeleteOption(force),
+			}, nil, &deleResp)
+	}
 	if err != nil {
 		return err
 	}
@@ -210,6 +241,92 @@ func deleteInstance(ctx context.Context, sharedClient instanceSharedClient, d *u
 	return d.Error()
 }
 
+// instanceGetLastProcessID returns the ID of the latest history process of the instance
+func instanceGetLastProcessID(ctx context.Context, sharedClient instanceSharedClient, instanceID int) (int, error) {
+	history, err := sharedClient.iClient.GetInstanceHistory(ctx, instanceID)
+	if err != nil {
+		return 0, err
+	}
+	lastProcessID := 0
+	for _, p := range history.Processes {
+		if p.ID > lastProcessID {
+			lastProcessID = p.ID
+		}
+	}
+
+	return lastProcessID, nil
+}
+
+// instanceWaitForHistory waits for the first history entry created after
+// lastProcessID to complete. Returns an error if no history entry is created
+// within instanceHistoryTimeout.
+func instanceWaitForHistory(
+	ctx context.Context,
+	sharedClient instanceSharedClient,
+	meta interface{},
+	instanceID int,
+	lastProcessID int,
+) (*models.GetInstanceHistoryProcesses, error) {
+	var process *models.GetInstanceHistoryProcesses
+	startTime := time.Now()
+	errCount := 0
+	historyRetry := utils.CustomRetry{
+		InitialDelay: time.Second * 5,
+		RetryDelay:   time.Second * 10,
+		Timeout:      maxTimeout,
+		Cond: func(response interface{}, ResponseErr error) (bool, error) {
+			if ResponseErr != nil {
+				errCount++
+				if errCount == 3 {
+					return false, ResponseErr
+				}
+
+				return false, nil
+			}
+			errCount = 0
+
+			process = nil
+			instanceHistory := response.(models.GetInstanceHistory)
+			for idx, p := range instanceHistory.Processes {
+				if p.ID > lastProcessID && (process == nil || p.ID < process.ID) {
+					process = &instanceHistory.Processes[idx]
+				}
+			}
+			if process == nil {
+				if time.Since(startTime) > instanceHistoryTimeout {
+					return false, fmt.Errorf("no history entry found within %s", instanceHistoryTimeout)
+				}
+
+				return false, nil
+			}
+
+			switch process.Status {
+			case "success", "complete":
+				return true, nil
+			case utils.StateFailed:
+				return false, fmt.Errorf("%s failed: %v", process.DisplayName, process.Reason)
+			}
+
+			return false, nil
+		},
+	}
+	_, err := historyRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
+		return sharedClient.iClient.GetInstanceHistory(ctx, instanceID)
+	})
+
+	return process, err
+}
+
+// instanceDeleteOption converts bool to the on/off value expected by
+// instance delete API
+func instanceDeleteOption(v bool) string {
+	if v {
+		return "on"
+	}
+
+	return "off"
+}
+
 func instanceGetVolume(volumes []map[string]interface{}) []models.CreateInstanceBodyVolumes {
 	volumesModel := make([]models.CreateInstanceBodyVolumes, 0, len(volumes))
 	for i := range volumes {
@@ -527,6 +644,34 @@ func instanceGetHistoryModel(retry *utils.CustomRetry) []models.GetInstanceHisto
 	return historyModel.Processes
 }
 
+// instanceGetStaticIPs returns the static IP addresses of the networks
+func instanceGetStaticIPs(networks []map[string]interface{}) []string {
+	ips := make([]string, len(networks))
+	for i, n := range networks {
+		ips[i], _ = n["ip_address"].(string)
+	}
+
+	return ips
+}
+
+// instanceSetStaticIPs sets the static IP addresses of the networks, since
+// static IP addresses are not part of the instance model of cmp-go-sdk
+func instanceSetStaticIPs(d *utils.Data, ips []string) error {
+	isStatic := false
+	for _, ip := range ips {
+		isStatic = isStatic || ip != ""
+	}
+	networks := d.GetListMap("network")
+	if !isStatic || len(networks) != len(ips) {
+		return nil
+	}
+	for i := range networks {
+		networks[i]["ip_address"] = ips[i]
+	}
+
+	return d.Set("network", networks)
+}
+
 func instanceGetResizeNetwork(network []map[string]interface{}) []models.CreateInstanceBodyNetworkInterfaces {
 	nics := make([]models.CreateInstanceBodyNetworkInterfaces, 0, len(network))
 	for _, n := range network {
@@ -543,6 +688,27 @@ func instanceGetResizeNetwork(network []map[string]interface{}) []models.CreateI
 	return nics
 }
 
+// instanceSetServerIDFromInstance sets server_id from the servers of the
+// instance itself, and falls back to server lookup by name.
+func instanceSetServerIDFromInstance(
+	ctx context.Context,
+	d *utils.Data,
+	sharedClient instanceSharedClient,
+	instanceID int,
+) error {
+	instance, err := sharedClient.iClient.GetASpecificInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	if servers, ok := instance.Instance.Servers.([]interface{}); ok && len(servers) == 1 {
+		if serverID, ok := servers[0].(float64); ok {
+			return d.Set("server_id", int(serverID))
+		}
+	}
+
+	return instanceSetServerID(ctx, d, sharedClient)
+}
+
 func instanceSetServerID(ctx context.Context, d *utils.Data, sharedClient instanceSharedClient) error {
 	servers, err := sharedClient.sClient.GetAllServers(ctx, map[string]string{
 		externalNameKey: d.GetString("name"),
@@ -584,6 +750,7 @@ func instanceUpdateNetworkVolumePlan(
 	ctx context.Context,
 	sharedClient instanceSharedClient,
 	d *utils.Data,
+	meta interface{},
 	instanceID int,
 ) error {
 	var resizeReq models.ResizeInstanceBody
@@ -620,18 +787,172 @@ func instanceUpdateNetworkVolumePlan(
 		resizeReq.NetworkInterfaces = instanceGetResizeNetwork(schemaNetwork)
 	}
 	if d.HasChanged("volume") || d.HasChanged("network") || d.HasChanged("plan_id") {
-		updateResp, err := sharedClient.iClient.ResizeAnInstance(ctx, instanceID, &resizeReq)
-		if err != nil {
-			return err
+		if d.GetBool("allow_stop_for_update") {
+			return instanceResizeWithPowerCycle(ctx, sharedClient, meta, instanceID, &resizeReq)
 		}
-		if !updateResp.Success {
-			return fmt.Errorf("%s", "failed to resize")
+
+		return instanceResize(ctx, sharedClient, instanceID, &resizeReq)
+	}
+
+	return nil
+}
+
+func instanceResize(
+	ctx context.Context,
+	sharedClient instanceSharedClient,
+	instanceID int,
+	resizeReq *models.ResizeInstanceBody,
+) error {
+	updateResp, err := sharedClient.iClient.ResizeAnInstance(ctx, instanceID, resizeReq)
+	if err != nil {
+		return err
+	}
+	if !updateResp.Success {
+		return fmt.Errorf("%s", "failed to resize")
+	}
+
+	return nil
+}
+
+// instanceResizeWithPowerCycle powers off a running or suspended instance,
+// applies the resize and then restores the original power state. Instances
+// which are already stopped are resized directly.
+func instanceResizeWithPowerCycle(
+	ctx context.Context,
+	sharedClient instanceSharedClient,
+	meta interface{},
+	instanceID int,
+	resizeReq *models.ResizeInstanceBody,
+) error {
+	getInstance, err := sharedClient.iClient.GetASpecificInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	originalPower := utils.ParsePowerState(getInstance.Instance.Status)
+	if originalPower != utils.PowerOn && originalPower != utils.Suspend {
+		return instanceResize(ctx, sharedClient, instanceID, resizeReq)
+	}
+
+	log.Printf("[INFO] Powering off instance %d before resize", instanceID)
+	if err := instanceDoPowerTask(ctx, sharedClient, instanceID, utils.PowerOff); err != nil {
+		return fmt.Errorf("failed to power off instance %d before resize: %w", instanceID, err)
+	}
+	if err := instanceWaitForStatus(ctx, sharedClient, meta, instanceID, utils.StateStopped); err != nil {
+		return fmt.Errorf("failed while waiting for instance %d to power off before resize: %w", instanceID, err)
+	}
+
+	log.Printf("[INFO] Resizing powered off instance %d", instanceID)
+	resizeErr := instanceResizeAndWait(ctx, sharedClient, meta, instanceID, resizeReq)
+
+	log.Printf("[INFO] Restoring power state %q of instance %d", originalPower, instanceID)
+	if err := instanceRestorePower(ctx, sharedClient, meta, instanceID, originalPower); err != nil {
+		if resizeErr != nil {
+			return fmt.Errorf("failed to resize instance %d: %v. Instance is left powered off, "+
+				"failed to restore power state %q: %w", instanceID, resizeErr, originalPower, err)
 		}
+
+		return fmt.Errorf("instance %d is resized but failed to restore power state %q: %w",
+			instanceID, originalPower, err)
+	}
+	if resizeErr != nil {
+		return fmt.Errorf("failed to resize instance %d, power state %q is restored: %w",
+			instanceID, originalPower, resizeErr)
 	}
 
 	return nil
 }
 
+// instanceResizeAndWait resizes a stopped instance and waits for the resize
+// history entry to complete. Status of the instance can not be used here, since
+// the instance is already stopped when resize is requested.
+func instanceResizeAndWait(
+	ctx context.Context,
+	sharedClient instanceSharedClient,
+	meta interface{},
+	instanceID int,
+	resizeReq *models.ResizeInstanceBody,
+) error {
+	lastProcessID, err := instanceGetLastProcessID(ctx, sharedClient, instanceID)
+	if err != nil {
+		return err
+	}
+	if err := instanceResize(ctx, sharedClient, instanceID, resizeReq); err != nil {
+		return err
+	}
+	if _, err := instanceWaitForHistory(ctx, sharedClient, meta, instanceID, lastProcessID); err != nil {
+		return err
+	}
+
+	return instanceWaitForStatus(ctx, sharedClient, meta, instanceID, utils.StateStopped)
+}
+
+// instanceRestorePower powers on a stopped instance and suspends it again
+// if the instance was originally suspended.
+func instanceRestorePower(
+	ctx context.Context,
+	sharedClient instanceSharedClient,
+	meta interface{},
+	instanceID int,
+	powerState string,
+) error {
+	if err := instanceDoPowerTask(ctx, sharedClient, instanceID, utils.PowerOn); err != nil {
+		return err
+	}
+	if err := instanceWaitForStatus(ctx, sharedClient, meta, instanceID, utils.StateRunning); err != nil {
+		return err
+	}
+	if powerState != utils.Suspend {
+		return nil
+	}
+	if err := instanceDoPowerTask(ctx, sharedClient, instanceID, utils.Suspend); err != nil {
+		return err
+	}
+
+	return instanceWaitForStatus(ctx, sharedClient, meta, instanceID, utils.StateSuspended)
+}
+
+// instanceWaitForStatus waits until the instance reaches the target status. Returns
+// error if the instance goes to failed state.
+func instanceWaitForStatus(
+	ctx context.Context,
+	sharedClient instanceSharedClient,
+	meta interface{},
+	instanceID int,
+	target string,
+) error {
+	errCount := 0
+	cRetry := utils.CustomRetry{
+		Timeout:      instancePowerTimeout,
+		RetryDelay:   time.Second * 10,
+		InitialDelay: time.Second * 5,
+		Cond: func(response interface{}, err error) (bool, error) {
+			if err != nil {
+				errCount++
+				if errCount == 3 {
+					return false, err
+				}
+
+				return false, nil
+			}
+			errCount = 0
+
+			instance := response.(models.GetInstanceResponse)
+			if instance.Instance.Status == utils.StateFailed {
+				return false, fmt.Errorf("instance went to %s state while waiting for %s state",
+					utils.StateFailed, target)
+			}
+
+			return instance.Instance.Status == target, nil
+		},
+	}
+
+	_, err := cRetry.Retry(ctx, meta, func(ctx context.Context) (interface{}, error) {
+		return sharedClient.iClient.GetASpecificInstance(ctx, instanceID)
+	})
+
+	return err
+}
+
 func instanceUpdateTags(tags []models.CreateInstanceBodyTag) interface{} {
 	if len(tags) == 0 {
 		return nil
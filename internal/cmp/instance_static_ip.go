@@ -0,0 +1,50 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"fmt"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+)
+
+// instanceCreateBody is the create request of an instance along with the
+// static IP addresses of the network interfaces, which are not part of the
+// sdk model
+type instanceCreateBody struct {
+	*models.CreateInstanceBody
+	NetworkInterfaces []instanceNetworkInterface `json:"networkInterfaces"`
+}
+
+type instanceNetworkInterface struct {
+	models.CreateInstanceBodyNetworkInterfaces
+	IPAddress string `json:"ipAddress,omitempty"`
+	IPMode    string `json:"ipMode,omitempty"`
+}
+
+// newInstanceCreateBody returns the create request with the static IP
+// addresses, ips are in the order of the network interfaces. Empty string
+// means the IP address is not static. Resource pool ID is prefixed with
+// 'pool-' if supported, as cmp-go-sdk does.
+func newInstanceCreateBody(
+	req *models.CreateInstanceBody,
+	ips []string,
+	capabilities *Capabilities,
+) instanceCreateBody {
+	nics := make([]instanceNetworkInterface, len(req.NetworkInterfaces))
+	for i, nic := range req.NetworkInterfaces {
+		nics[i].CreateInstanceBodyNetworkInterfaces = nic
+		if i < len(ips) && ips[i] != "" {
+			nics[i].IPAddress = ips[i]
+			nics[i].IPMode = instanceStaticIPMode
+		}
+	}
+	if capabilities.Supports(CapResourcePoolPrefix) && req.Config != nil && req.Config.ResourcePoolID != nil {
+		req.Config.ResourcePoolID = fmt.Sprintf("pool-%v", req.Config.ResourcePoolID)
+	}
+
+	return instanceCreateBody{
+		CreateInstanceBody: req,
+		NetworkInterfaces:  nics,
+	}
+}
@@ -0,0 +1,100 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+)
+
+func TestNewInstanceCreateBody(t *testing.T) {
+	tests := []struct {
+		name       string
+		ips        []string
+		version    string
+		poolID     interface{}
+		wantIPs    []string
+		wantModes  []string
+		wantPoolID interface{}
+	}{
+		{
+			name:       "Test case 1: static IP of second network",
+			ips:        []string{"", "10.0.0.5"},
+			version:    "6.0.2",
+			poolID:     "1",
+			wantIPs:    []string{"", "10.0.0.5"},
+			wantModes:  []string{"", instanceStaticIPMode},
+			wantPoolID: "1",
+		},
+		{
+			name:       "Test case 2: prefixed resource pool ID",
+			ips:        []string{"10.0.0.4", "10.0.0.5"},
+			version:    "6.0.3",
+			poolID:     "1",
+			wantIPs:    []string{"10.0.0.4", "10.0.0.5"},
+			wantModes:  []string{instanceStaticIPMode, instanceStaticIPMode},
+			wantPoolID: "pool-1",
+		},
+		{
+			name:      "Test case 3: less IP addresses than networks",
+			ips:       []string{"10.0.0.4"},
+			version:   "6.0.3",
+			wantIPs:   []string{"10.0.0.4", ""},
+			wantModes: []string{instanceStaticIPMode, ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, err := ParseVersion(tt.version)
+			if err != nil {
+				t.Fatalf("ParseVersion() error = %v", err)
+			}
+			req := &models.CreateInstanceBody{
+				NetworkInterfaces: []models.CreateInstanceBodyNetworkInterfaces{
+					{Network: &models.CreateInstanceBodyNetwork{ID: 1}},
+					{Network: &models.CreateInstanceBodyNetwork{ID: 2}},
+				},
+				Config: &models.CreateInstanceBodyConfig{ResourcePoolID: tt.poolID},
+			}
+			body, err := json.Marshal(newInstanceCreateBody(req, tt.ips, newCapabilities(version)))
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			var got struct {
+				NetworkInterfaces []struct {
+					Network struct {
+						ID int `json:"id"`
+					} `json:"network"`
+					IPAddress string `json:"ipAddress"`
+					IPMode    string `json:"ipMode"`
+				} `json:"networkInterfaces"`
+				Config struct {
+					ResourcePoolID interface{} `json:"resourcePoolId"`
+				} `json:"config"`
+			}
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatalf("invalid request body %s: %v", body, err)
+			}
+			var gotIPs, gotModes []string
+			for i, nic := range got.NetworkInterfaces {
+				if want := req.NetworkInterfaces[i].Network.ID; nic.Network.ID != want {
+					t.Errorf("network = %v, want %v", nic.Network.ID, want)
+				}
+				gotIPs = append(gotIPs, nic.IPAddress)
+				gotModes = append(gotModes, nic.IPMode)
+			}
+			if !reflect.DeepEqual(gotIPs, tt.wantIPs) {
+				t.Errorf("ipAddress = %v, want %v", gotIPs, tt.wantIPs)
+			}
+			if !reflect.DeepEqual(gotModes, tt.wantModes) {
+				t.Errorf("ipMode = %v, want %v", gotModes, tt.wantModes)
+			}
+			if got.Config.ResourcePoolID != tt.wantPoolID {
+				t.Errorf("resourcePoolId = %v, want %v", got.Config.ResourcePoolID, tt.wantPoolID)
+			}
+		})
+	}
+}
@@ -1,54 +1,40 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
-	"fmt"
-	"log"
 
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 )
 
-type layout struct {
-	gClient *client.LibraryAPIService
-}
-
-func newLayout(gClient *client.LibraryAPIService) *layout {
-	return &layout{
-		gClient: gClient,
-	}
-}
-
-func (g *layout) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, g.gClient.Client)
-	log.Printf("[DEBUG] Get Layout")
-
-	name := d.GetString("name")
-	instanceTypeCode := d.GetString("instance_type_code")
-	// Pre check
-	if err := d.Error(); err != nil {
-		return err
+// newLayout looks up the vmware layouts of the instance type. Layouts are
+// fetched directly from CMP API, since sdk does not list the layouts.
+func newLayout(api *cmpAPI) *catalogueLookup[cmpLayout] {
+	return &catalogueLookup[cmpLayout]{
+		catalogue: catalogue[cmpLayout]{
+			api:     api,
+			kind:    "layout",
+			path:    func(d *utils.Data) string { return "library/layouts" },
+			listKey: "instanceTypeLayouts",
+			itemKey: "instanceTypeLayout",
+			item: func(l cmpLayout) (int, string, map[string]interface{}) {
+				return l.ID, l.Name, map[string]interface{}{
+					"code":               l.Code,
+					"instance_version":   l.InstanceVersion,
+					"memory_requirement": l.MemoryRequirement,
+					"sort_order":         l.SortOrder,
+					"creatable":          l.Creatable,
+				}
+			},
+			dateCreated: func(l cmpLayout) string { return l.DateCreated },
+		},
+		filter: func(ctx context.Context, d *utils.Data) (func(cmpLayout) bool, error) {
+			instanceTypeCode := d.GetString("instance_type_code")
+
+			return func(l cmpLayout) bool {
+				return l.InstanceType.Code == instanceTypeCode && l.ProvisionType.Code == vmware
+			}, d.Error()
+		},
 	}
-	instanceTypes, err := g.gClient.GetAllInstanceTypes(ctx, map[string]string{
-		codeKey:          instanceTypeCode,
-		provisionTypeKey: vmware,
-	})
-	if err != nil {
-		return err
-	}
-
-	if len(instanceTypes.InstanceTypes) != 1 {
-		return fmt.Errorf(errExactMatch, "instance type")
-	}
-	for _, l := range instanceTypes.InstanceTypes[0].Instancetypelayouts {
-		if l.Name == name {
-			d.SetID(l.ID)
-
-			return d.Error()
-		}
-	}
-
-	return fmt.Errorf(errExactMatch, "layout")
 }
@@ -1,42 +1,104 @@
-// (C) Copyright 2022 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2022-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
 	"github.com/tshihad/tftags"
 )
 
 type loadBalancerMonitor struct {
 	lbClient *client.LoadBalancerAPIService
+	api      *cmpAPI
 }
 
-func newLoadBalancerMonitor(loadBalancerClient *client.LoadBalancerAPIService) *loadBalancerMonitor {
+// lbMonitorBlocks maps the monitor types to the type specific block and the
+// attributes of the block
+var lbMonitorBlocks = map[string]struct {
+	block string
+	attrs []string
+}{
+	"LBHttpMonitorProfile": {"http_monitor", []string{
+		"timeout", "interval", "request_version", "request_method", "response_status_codes",
+		"response_data", "request_url", "request_body", "monitor_port", "rise_count", "fall_count",
+	}},
+	"LBHttpsMonitorProfile": {"https_monitor", []string{
+		"timeout", "interval", "request_version", "request_method", "response_status_codes",
+		"response_data", "request_url", "request_body", "monitor_port", "rise_count", "fall_count",
+	}},
+	"LBIcmpMonitorProfile": {"icmp_monitor", []string{
+		"fall_count", "interval", "monitor_port", "rise_count", "data_length", "timeout",
+	}},
+	"LBPassiveMonitorProfile": {"passive_monitor", []string{"timeout", "max_fail"}},
+	"LBTcpMonitorProfile": {"tcp_monitor", []string{
+		"fall_count", "interval", "monitor_port", "rise_count", "timeout", "request_body", "response_data",
+	}},
+	"LBUdpMonitorProfile": {"udp_monitor", []string{
+		"fall_count", "interval", "monitor_port", "rise_count", "timeout", "request_body", "response_data",
+	}},
+}
+
+func newLoadBalancerMonitor(loadBalancerClient *client.LoadBalancerAPIService, api *cmpAPI) *loadBalancerMonitor {
 	return &loadBalancerMonitor{
 		lbClient: loadBalancerClient,
+		api:      api,
 	}
 }
 
 func (lb *loadBalancerMonitor) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
 	setMeta(meta, lb.lbClient.Client)
-	var lbMonitorResp models.CreateLBMonitorReq
-	if err := tftags.Get(d, &lbMonitorResp); err != nil {
-		return err
-	}
+	// monitor type is not part of the sdk get response model, hence the
+	// response is decoded to the create model
+	var resp models.CreateLBMonitor
+	path := fmt.Sprintf("%s/%d/%s/%d", consts.LoadBalancerPath, d.GetInt("lb_id"),
+		consts.LoadBalancerMonitorPath, d.GetID())
+	if err := lb.api.do(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
 
-	getMonitorLoadBalancer, err := lb.lbClient.GetSpecificLBMonitor(ctx, lbMonitorResp.LbID,
-		lbMonitorResp.ID)
-	if err != nil {
 		return err
 	}
 
-	return tftags.Set(d, getMonitorLoadBalancer.GetSpecificLBMonitorResp)
+	monitor := resp.CreateLBMonitorReq
+	d.SetString("name", monitor.Name)
+	d.SetString("description", monitor.Description)
+	d.SetString("type", monitor.Type)
+	if monitorBlock, ok := lbMonitorBlocks[monitor.Type]; ok {
+		values := map[string]interface{}{
+			"timeout":               monitor.Timeout,
+			"interval":              monitor.Interval,
+			"request_version":       monitor.RequestVersion,
+			"request_method":        monitor.RequestMethod,
+			"response_status_codes": monitor.ResponseStatusCodes,
+			"response_data":         monitor.ResponseData,
+			"request_url":           monitor.RequestURL,
+			"request_body":          monitor.RequestBody,
+			"monitor_port":          monitor.AliasPort,
+			"rise_count":            monitor.RiseCount,
+			"fall_count":            monitor.FallCount,
+			"data_length":           monitor.DataLength,
+			"max_fail":              monitor.MaxFail,
+		}
+		block := make(map[string]interface{}, len(monitorBlock.attrs))
+		for _, attr := range monitorBlock.attrs {
+			block[attr] = values[attr]
+		}
+		d.Set(monitorBlock.block, []map[string]interface{}{block})
+	}
+
+	return d.Error()
 }
 
 func (lb *loadBalancerMonitor) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
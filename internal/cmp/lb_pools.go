@@ -1,15 +1,17 @@
-// (C) Copyright 2022 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2022-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
 	"github.com/tshihad/tftags"
 )
 
@@ -25,17 +27,24 @@ func newLoadBalancerPool(loadBalancerClient *client.LoadBalancerAPIService) *loa
 
 func (lb *loadBalancerPool) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
 	setMeta(meta, lb.lbClient.Client)
-	var lbPoolResp models.CreateLBPoolReq
-	if err := tftags.Get(d, &lbPoolResp); err != nil {
-		return err
-	}
-
-	getPoolLoadBalancer, err := lb.lbClient.GetSpecificLBPool(ctx, lbPoolResp.LbID, lbPoolResp.ID)
+	getPoolLoadBalancer, err := lb.lbClient.GetSpecificLBPool(ctx, d.GetInt("lb_id"), d.GetID())
 	if err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
+
 		return err
 	}
 
-	return tftags.Set(d, getPoolLoadBalancer.GetSpecificLBPoolResp)
+	pool := getPoolLoadBalancer.GetSpecificLBPoolResp
+	d.SetString("name", pool.Name)
+	d.SetString("description", pool.Description)
+	d.SetString("algorithm", pool.VipBalance)
+	d.Set("min_active_members", pool.MinActive)
+
+	return d.Error()
 }
 
 func (lb *loadBalancerPool) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -1,15 +1,17 @@
-// (C) Copyright 2022 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2022-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
 	"github.com/tshihad/tftags"
 )
 
@@ -25,18 +27,25 @@ func newLoadBalancerProfile(loadBalancerClient *client.LoadBalancerAPIService) *
 
 func (lb *loadBalancerProfile) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
 	setMeta(meta, lb.lbClient.Client)
-	var lbProfileResp models.CreateLBProfileReq
+	getProfileLoadBalancer, err := lb.lbClient.GetSpecificLBProfile(ctx, d.GetInt("lb_id"), d.GetID())
+	if err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
 
-	if err := tftags.Get(d, &lbProfileResp); err != nil {
 		return err
 	}
 
-	getProfileLoadBalancer, err := lb.lbClient.GetSpecificLBProfile(ctx, lbProfileResp.LbID, lbProfileResp.ID)
-	if err != nil {
-		return err
+	profile := getProfileLoadBalancer.GetLBSpecificProfilesResp
+	d.SetString("name", profile.Name)
+	d.SetString("description", profile.Description)
+	if profile.LBProfileConfig.ProfileType != "" {
+		d.SetString("profile_type", profile.LBProfileConfig.ProfileType)
 	}
 
-	return tftags.Set(d, getProfileLoadBalancer.GetLBSpecificProfilesResp)
+	return d.Error()
 }
 
 func (lb *loadBalancerProfile) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -1,15 +1,18 @@
-// (C) Copyright 2022 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2022-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
 	"github.com/tshihad/tftags"
 )
 
@@ -25,18 +28,26 @@ func newLoadBalancerVirtualServer(loadBalancerClient *client.LoadBalancerAPIServ
 
 func (lb *loadBalancerVirtualServer) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
 	setMeta(meta, lb.lbClient.Client)
-	var lbVSResp models.CreateLBVirtualServersReq
+	getlbVirtualServerResp, err := lb.lbClient.GetSpecificLBVirtualServer(ctx, d.GetInt("lb_id"), d.GetID())
+	if err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
 
-	if err := tftags.Get(d, &lbVSResp); err != nil {
-		return err
-	}
+			return nil
+		}
 
-	getlbVirtualServerResp, err := lb.lbClient.GetSpecificLBVirtualServer(ctx, lbVSResp.LbID, lbVSResp.ID)
-	if err != nil {
 		return err
 	}
 
-	return tftags.Set(d, getlbVirtualServerResp.GetSpecificLBVirtualServersResp)
+	virtualServer := getlbVirtualServerResp.GetSpecificLBVirtualServersResp
+	d.SetString("name", virtualServer.VipName)
+	d.SetString("description", virtualServer.Description)
+	d.SetString("vip_address", virtualServer.VipAddress)
+	d.SetString("vip_port", strconv.Itoa(virtualServer.VipPort))
+	d.Set("pool", virtualServer.VSPool.ID)
+	d.SetString("type", virtualServer.VipProtocol)
+
+	return d.Error()
 }
 
 func (lb *loadBalancerVirtualServer) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -1,47 +1,53 @@
-// (C) Copyright 2022 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2022-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
-	"fmt"
-	"log"
 
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
-	"github.com/tshihad/tftags"
 )
 
-type loadBalancerds struct {
-	lbClient *client.LoadBalancerAPIService
-}
-
-func newLoadBalancerDS(loadBalancerClient *client.LoadBalancerAPIService) *loadBalancerds {
-	return &loadBalancerds{lbClient: loadBalancerClient}
+func loadBalancerCatalogue(api *cmpAPI) catalogue[models.GetNetworkLoadBalancerResp] {
+	return catalogue[models.GetNetworkLoadBalancerResp]{
+		api:     api,
+		kind:    "load balancer",
+		path:    func(d *utils.Data) string { return "load-balancers" },
+		listKey: "loadBalancers",
+		itemKey: "loadBalancer",
+		item: func(lb models.GetNetworkLoadBalancerResp) (int, string, map[string]interface{}) {
+			return lb.ID, lb.Name, loadBalancerAttributes(lb)
+		},
+		dateCreated: func(lb models.GetNetworkLoadBalancerResp) string { return lb.DateCreated },
+	}
 }
 
-func (n *loadBalancerds) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, n.lbClient.Client)
-	log.Printf("[DEBUG] Get Load balancer")
-	name := d.GetString("name")
+func newLoadBalancerDS(api *cmpAPI) *catalogueLookup[models.GetNetworkLoadBalancerResp] {
+	c := loadBalancerCatalogue(api)
+	// lb_id is the ID of the load balancer, as used by the load balancer resources
+	c.item = func(lb models.GetNetworkLoadBalancerResp) (int, string, map[string]interface{}) {
+		attributes := loadBalancerAttributes(lb)
+		attributes["lb_id"] = lb.ID
 
-	// Pre check
-	if err := d.Error(); err != nil {
-		return err
+		return lb.ID, lb.Name, attributes
 	}
 
-	lb, err := n.lbClient.GetLoadBalancers(ctx)
-	if err != nil {
-		return err
+	return &catalogueLookup[models.GetNetworkLoadBalancerResp]{
+		catalogue: c,
 	}
+}
 
-	for i, n := range lb.GetNetworkLoadBalancerResp {
-		if n.Name == name {
-			log.Print("[DEBUG]", lb.GetNetworkLoadBalancerResp[i].ID)
-
-			return tftags.Set(d, lb.GetNetworkLoadBalancerResp[i])
-		}
+func newLoadBalancerList(api *cmpAPI) *catalogueList[models.GetNetworkLoadBalancerResp] {
+	return &catalogueList[models.GetNetworkLoadBalancerResp]{
+		catalogue: loadBalancerCatalogue(api),
+		attribute: "load_balancers",
+		filter: func(ctx context.Context, d *utils.Data) (func(models.GetNetworkLoadBalancerResp) bool, error) {
+			cloudID := d.GetInt("cloud_id")
+
+			return func(lb models.GetNetworkLoadBalancerResp) bool {
+				return cloudID == 0 || lb.Cloud.ID == cloudID
+			}, nil
+		},
 	}
-
-	return fmt.Errorf(errExactMatch, "LoadBalancer")
 }
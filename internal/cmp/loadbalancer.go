@@ -1,44 +1,76 @@
-// (C) Copyright 2022 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2022-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
 	"github.com/tshihad/tftags"
 )
 
 type loadBalancer struct {
 	lbClient *client.LoadBalancerAPIService
 	rClient  *client.RouterAPIService
+	cache    *cache
 }
 
-func newLoadBalancer(loadBalancerClient *client.LoadBalancerAPIService, routerClient *client.RouterAPIService) *loadBalancer {
+func newLoadBalancer(
+	loadBalancerClient *client.LoadBalancerAPIService,
+	routerClient *client.RouterAPIService,
+	c *cache,
+) *loadBalancer {
 	return &loadBalancer{
 		lbClient: loadBalancerClient,
 		rClient:  routerClient,
+		cache:    c,
 	}
 }
 
 func (lb *loadBalancer) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	var loadBalancerResp models.GetSpecificNetworkLoadBalancerResp
-	if err := tftags.Get(d, &loadBalancerResp); err != nil {
+	setMeta(meta, lb.lbClient.Client)
+	getResLoadBalancer, err := lb.lbClient.GetSpecificLoadBalancers(ctx, d.GetID())
+	if err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
+
 		return err
 	}
-	getResLoadBalancer, err := lb.lbClient.GetSpecificLoadBalancers(ctx, loadBalancerResp.ID)
+
+	// network server is not part of the get response, and is required by
+	// update after import
+	serverID, err := lb.cache.getNsxNetworkServerID(ctx, lb.rClient)
 	if err != nil {
 		return err
 	}
-
-	return tftags.Set(d, getResLoadBalancer.GetSpecificNetworkLoadBalancerResp)
+	d.Set("network_server_id", serverID)
+
+	loadBalancer := getResLoadBalancer.GetSpecificNetworkLoadBalancerResp
+	d.SetString("name", loadBalancer.Name)
+	d.SetString("description", loadBalancer.Description)
+	d.Set("enabled", loadBalancer.Enabled)
+	d.SetString("lb_type", loadBalancer.Type.Code)
+	d.Set("config", []map[string]interface{}{{
+		"admin_state":    loadBalancer.Config.AdminState,
+		"size":           loadBalancer.Config.Size,
+		"log_level":      loadBalancer.Config.Loglevel,
+		"tier1_gateways": loadBalancer.Config.Tier1,
+	}})
+
+	return d.Error()
 }
 
 func (lb *loadBalancer) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer lb.cache.invalidate(loadBalancersCachePath)
 	id := d.GetID()
 	var updateReq models.CreateLoadBalancerRequest
 	if err := tftags.Get(d, &updateReq.NetworkLoadBalancer); err != nil {
@@ -61,7 +93,7 @@ func (lb *loadBalancer) Update(ctx context.Context, d *utils.Data, meta interfac
 
 func (lb *loadBalancer) loadBalancerAlignRequest(ctx context.Context, meta interface{},
 	createReq *models.CreateLoadBalancerRequest) error {
-	nsxType, err := GetNsxTypeFromCMP(ctx, lb.rClient.Client)
+	nsxType, err := lb.cache.getNsxType(ctx, lb.rClient.Client)
 	if err != nil {
 		return err
 	}
@@ -73,7 +105,7 @@ func (lb *loadBalancer) loadBalancerAlignRequest(ctx context.Context, meta inter
 	setMeta(meta, lb.rClient.Client)
 	nsRetry := utils.CustomRetry{}
 	nsRetry.RetryParallel(ctx, meta, func(ctx context.Context) (interface{}, error) {
-		return lb.rClient.GetNetworkServices(ctx, nil)
+		return lb.cache.getNetworkServices(ctx, lb.rClient)
 	})
 
 	// Align Network Server
@@ -102,6 +134,7 @@ func (lb *loadBalancer) loadBalancerAlignRequest(ctx context.Context, meta inter
 }
 
 func (lb *loadBalancer) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer lb.cache.invalidate(loadBalancersCachePath)
 	setMeta(meta, lb.lbClient.Client)
 	var createReq models.CreateLoadBalancerRequest
 	if err := tftags.Get(d, &createReq.NetworkLoadBalancer); err != nil {
@@ -138,6 +171,7 @@ func (lb *loadBalancer) Create(ctx context.Context, d *utils.Data, meta interfac
 }
 
 func (lb *loadBalancer) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer lb.cache.invalidate(loadBalancersCachePath)
 	lbID := d.GetID()
 	_, err := lb.lbClient.DeleteLoadBalancer(ctx, lbID)
 	if err != nil {
@@ -5,52 +5,96 @@ package cmp
 import (
 	"context"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 )
 
 // morpheusBroker is used to read morpheus details using the Broker API
 type morpheusBroker struct {
 	bClient *client.BrokerAPIService
+	details BrokerDetails
 }
 
-func newMorpheusBroker(bClient *client.BrokerAPIService) *morpheusBroker {
-	return &morpheusBroker{bClient: bClient}
+func newMorpheusBroker(bClient *client.BrokerAPIService, details BrokerDetails) *morpheusBroker {
+	return &morpheusBroker{bClient: bClient, details: details}
 }
 
 // Read reads the morpheus details using the Broker API
 func (m *morpheusBroker) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
 	setMetaHpegl(meta, m.bClient.Client)
 
+	refreshBefore := time.Duration(0)
+	if v := d.GetString("refresh_before"); v != "" {
+		var err error
+		if refreshBefore, err = time.ParseDuration(v); err != nil {
+			return fmt.Errorf("invalid refresh_before %s: %w", v, err)
+		}
+	}
+
 	// Get Morpheus Tokens and URL
-	morpheusDetails, err := m.bClient.GetMorpheusDetails(ctx)
+	morpheusDetails, err := m.getMorpheusDetails(ctx, refreshBefore)
 	if err != nil {
 		return err
 	}
+	expiresAt := time.UnixMilli(morpheusDetails.ValidTill)
 
 	// Convert the Unix timestamp to Duration in seconds expressed as a string
-	validDuration := time.Until(time.UnixMilli(morpheusDetails.ValidTill))
+	validDuration := time.Until(expiresAt)
 	// We do the following since we cannot get a string representation of a Duration in seconds
 	validSeconds := validDuration.Round(time.Second).Seconds() // Round to the nearest second, in float64
 	validSecondsString := fmt.Sprintf("%ss", strconv.FormatFloat(validSeconds, 'f', -1, 64))
 
+	cmpVersion := ""
+	if version := m.bClient.Client.GetSCMVersion(); version != 0 {
+		cmpVersion = formatVersion(version)
+	}
+
 	// Set all of the details
 	d.SetId(morpheusDetails.ID)
+	d.SetString("access_token", morpheusDetails.AccessToken)
+	d.SetString("valid_till", validSecondsString)
+	d.SetString("expires_at", expiresAt.UTC().Format(time.RFC3339))
+	d.SetString("url", morpheusDetails.URL)
+	d.SetString("cmp_version", cmpVersion)
+	d.SetString("location", m.details.Location)
+	d.SetString("space_name", m.details.SpaceName)
+	d.SetString("tenant_id", m.details.TenantID)
 
-	if err = d.Set("access_token", morpheusDetails.AccessToken); err != nil {
-		return err
-	}
+	return d.Error()
+}
 
-	if err = d.Set("valid_till", validSecondsString); err != nil {
-		return err
+// getMorpheusDetails returns the morpheus details. The details are fetched
+// again if the access token expires within refreshBefore, so that the token
+// does not expire while it is used by the downstream providers. Broker may
+// return the same cached token again until it is close to the expiry, in that
+// case the token is returned with a warning.
+func (m *morpheusBroker) getMorpheusDetails(
+	ctx context.Context,
+	refreshBefore time.Duration,
+) (models.TFMorpheusDetails, error) {
+	morpheusDetails, err := m.bClient.GetMorpheusDetails(ctx)
+	if err != nil || refreshBefore == 0 || !expiresWithin(morpheusDetails, refreshBefore) {
+		return morpheusDetails, err
 	}
 
-	if err = d.Set("url", morpheusDetails.URL); err != nil {
-		return err
+	log.Printf("[INFO] Morpheus access token expires within %s, fetching a new token", refreshBefore)
+	morpheusDetails, err = m.bClient.GetMorpheusDetails(ctx)
+	if err != nil {
+		return morpheusDetails, err
 	}
+	if expiresWithin(morpheusDetails, refreshBefore) {
+		log.Printf("[WARN] Morpheus access token expires at %s, which is within refresh_before %s",
+			time.UnixMilli(morpheusDetails.ValidTill).UTC().Format(time.RFC3339), refreshBefore)
+	}
+
+	return morpheusDetails, nil
+}
 
-	return nil
+func expiresWithin(morpheusDetails models.TFMorpheusDetails, duration time.Duration) bool {
+	return time.Until(time.UnixMilli(morpheusDetails.ValidTill)) < duration
 }
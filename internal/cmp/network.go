@@ -5,42 +5,59 @@ package cmp
 import (
 	"context"
 	"fmt"
-	"log"
 
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 )
 
-type network struct {
-	nClient *client.NetworksAPIService
-}
-
-func newNetwork(nClient *client.NetworksAPIService) *network {
-	return &network{nClient: nClient}
+// networkCatalogue fetches networks directly from CMP API, since sdk model
+// of network does not contain cidr, gateway and dns details
+func networkCatalogue(api *cmpAPI) catalogue[cmpNetwork] {
+	return catalogue[cmpNetwork]{
+		api:     api,
+		kind:    "network",
+		path:    func(d *utils.Data) string { return "networks" },
+		listKey: "networks",
+		itemKey: "network",
+		item: func(n cmpNetwork) (int, string, map[string]interface{}) {
+			return n.ID, n.Name, networkAttributes(n)
+		},
+		dateCreated: func(n cmpNetwork) string { return n.DateCreated },
+	}
 }
 
-func (n *network) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, n.nClient.Client)
-	log.Printf("[DEBUG] Get Network")
-
-	name := d.GetString("name")
-	// Pre check
-	if err := d.Error(); err != nil {
-		return err
+func newNetwork(api *cmpAPI) *catalogueLookup[cmpNetwork] {
+	return &catalogueLookup[cmpNetwork]{
+		catalogue: networkCatalogue(api),
 	}
-	param := map[string]string{maxKey: "-1"}
-	networks, err := n.nClient.GetAllNetworks(ctx, param)
-	if err != nil {
-		return err
-	}
-
-	for i, n := range networks.Networks {
-		if n.Name == name {
-			d.SetID(networks.Networks[i].ID)
+}
 
-			return nil
-		}
+func newNetworkList(api *cmpAPI) *catalogueList[cmpNetwork] {
+	return &catalogueList[cmpNetwork]{
+		catalogue: networkCatalogue(api),
+		attribute: "networks",
+		filter: func(ctx context.Context, d *utils.Data) (func(cmpNetwork) bool, error) {
+			cloudIDs := map[int]bool{}
+			if cloudID := d.GetInt("cloud_id"); cloudID != 0 {
+				cloudIDs[cloudID] = true
+			}
+			if groupID := d.GetInt("group_id"); groupID != 0 {
+				// networks are listed from the clouds of the group
+				var groupResp models.GroupResp
+				if err := api.getCached(ctx, fmt.Sprintf("groups/%d", groupID), nil, &groupResp); err != nil {
+					return nil, err
+				}
+				if groupResp.Group != nil {
+					for _, z := range groupResp.Group.Zones {
+						cloudIDs[z.ID] = true
+					}
+				}
+			}
+			filterByCloud := d.GetInt("cloud_id") != 0 || d.GetInt("group_id") != 0
+
+			return func(n cmpNetwork) bool {
+				return !filterByCloud || cloudIDs[n.Zone.ID]
+			}, nil
+		},
 	}
-
-	return fmt.Errorf(errExactMatch, "Network")
 }
@@ -1,39 +1,28 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
-	"context"
-	"fmt"
-
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 )
 
-type networkPool struct {
-	nClient *client.NetworksAPIService
-}
-
-func newNetworkPool(nClient *client.NetworksAPIService) *networkPool {
-	return &networkPool{nClient: nClient}
-}
-
-func (n *networkPool) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, n.nClient.Client)
-	name := d.GetString("name")
-	poolResp, err := n.nClient.GetNetworkPool(ctx, nil)
-	if err != nil {
-		return err
+func newNetworkPool(api *cmpAPI) *catalogueLookup[models.GetNetworkPool] {
+	return &catalogueLookup[models.GetNetworkPool]{
+		catalogue: catalogue[models.GetNetworkPool]{
+			api:     api,
+			kind:    "network pool",
+			path:    func(d *utils.Data) string { return networkPoolsCachePath },
+			listKey: "networkPools",
+			itemKey: "networkPool",
+			item: func(p models.GetNetworkPool) (int, string, map[string]interface{}) {
+				return p.ID, p.Name, map[string]interface{}{
+					"display_name": p.DisplayName,
+					"code":         p.Code,
+					"category":     p.Category,
+					"external_id":  p.ExternalID,
+				}
+			},
+		},
 	}
-
-	for _, p := range poolResp.NetworkPools {
-		if p.Name == name {
-			d.SetString("display_name", p.DisplayName)
-			d.SetID(p.ID)
-
-			return nil
-		}
-	}
-
-	return fmt.Errorf(errExactMatch, "Network Pool")
 }
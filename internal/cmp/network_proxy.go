@@ -1,41 +1,28 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
-	"context"
-	"fmt"
-
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
-	"github.com/tshihad/tftags"
 )
 
-type networkProxy struct {
-	nClient *client.NetworksAPIService
-}
-
-func newNetworkProxy(nClient *client.NetworksAPIService) *networkProxy {
-	return &networkProxy{nClient: nClient}
-}
-
-func (n *networkProxy) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, n.nClient.Client)
-	tfProxy := models.GetNetworkProxy{}
-	err := tftags.Get(d, &tfProxy)
-	if err != nil {
-		return err
-	}
-	proxyResp, err := n.nClient.GetNetworkProxy(ctx, map[string]string{
-		nameKey: tfProxy.Name,
-	})
-	if err != nil {
-		return err
+func newNetworkProxy(api *cmpAPI) *catalogueLookup[models.GetNetworkProxy] {
+	return &catalogueLookup[models.GetNetworkProxy]{
+		catalogue: catalogue[models.GetNetworkProxy]{
+			api:       api,
+			kind:      "network proxy",
+			path:      func(d *utils.Data) string { return "networks/proxies" },
+			listKey:   "networkProxies",
+			itemKey:   "networkProxy",
+			nameQuery: true,
+			item: func(p models.GetNetworkProxy) (int, string, map[string]interface{}) {
+				return p.ID, p.Name, map[string]interface{}{
+					"proxy_host": p.ProxyHost,
+					"proxy_port": p.ProxyPort,
+					"visibility": p.Visibility,
+				}
+			},
+		},
 	}
-	if len(proxyResp.GetNetworkProxies) != 1 {
-		return fmt.Errorf(errExactMatch, "network proxy")
-	}
-
-	return tftags.Set(d, proxyResp.GetNetworkProxies[0])
 }
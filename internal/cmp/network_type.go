@@ -1,37 +1,28 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
-	"context"
-	"fmt"
-
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 )
 
-type networkType struct {
-	nClient *client.NetworksAPIService
-}
-
-func newNetworkType(nClient *client.NetworksAPIService) *networkType {
-	return &networkType{nClient: nClient}
-}
-
-func (n *networkType) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, n.nClient.Client)
-	name := d.GetString("name")
-	networkResp, err := n.nClient.GetNetworkType(ctx, map[string]string{
-		nameKey: name,
-	})
-	if err != nil {
-		return err
-	}
-	if len(networkResp.NetworkTypes) != 1 {
-		return fmt.Errorf(errExactMatch, "network-type")
+func newNetworkType(api *cmpAPI) *catalogueLookup[models.GetSpecificNetworkType] {
+	return &catalogueLookup[models.GetSpecificNetworkType]{
+		catalogue: catalogue[models.GetSpecificNetworkType]{
+			api:       api,
+			kind:      "network type",
+			path:      func(d *utils.Data) string { return "network-types" },
+			listKey:   "networkTypes",
+			itemKey:   "networkType",
+			nameQuery: true,
+			item: func(t models.GetSpecificNetworkType) (int, string, map[string]interface{}) {
+				return t.ID, t.Name, map[string]interface{}{
+					"code":        t.Code,
+					"description": t.Description,
+					"category":    t.Category,
+				}
+			},
+		},
 	}
-
-	d.SetID(networkResp.NetworkTypes[0].ID)
-
-	return nil
 }
@@ -0,0 +1,180 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
+)
+
+// nsxSecurityGroup manages the NSX-T groups of the NSX-T network server. The
+// sdk does not support the groups, so the groups are managed using cmpAPI.
+type nsxSecurityGroup struct {
+	rClient *client.RouterAPIService
+	api     *cmpAPI
+	cache   *cache
+}
+
+type nsxSecurityGroupBody struct {
+	Group nsxGroup `json:"group"`
+}
+
+type nsxGroup struct {
+	ID          int            `json:"id,omitempty"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	ExternalID  string         `json:"externalId,omitempty"`
+	Config      nsxGroupConfig `json:"config"`
+}
+
+type nsxGroupConfig struct {
+	Members     []string            `json:"members"`
+	IPAddresses []string            `json:"ipAddresses"`
+	Criteria    []nsxGroupCriterion `json:"criteria"`
+}
+
+// nsxGroupCriterion is a dynamic membership condition of a group
+type nsxGroupCriterion struct {
+	MemberType string `json:"memberType"`
+	Key        string `json:"key"`
+	Operator   string `json:"operator"`
+	Value      string `json:"value"`
+}
+
+type nsxSecurityGroupResp struct {
+	Success bool     `json:"success"`
+	ID      int      `json:"id"`
+	Group   nsxGroup `json:"group"`
+}
+
+func newNsxSecurityGroup(rClient *client.RouterAPIService, api *cmpAPI, c *cache) *nsxSecurityGroup {
+	return &nsxSecurityGroup{
+		rClient: rClient,
+		api:     api,
+		cache:   c,
+	}
+}
+
+func nsxGroupsPath(serverID int) string {
+	return fmt.Sprintf("%s/%s/%d/%s", consts.NetworksPath, consts.ServerPath, serverID, nsxSecurityGroupsPath)
+}
+
+func (n *nsxSecurityGroup) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, n.rClient.Client)
+	var resp nsxSecurityGroupResp
+	path := fmt.Sprintf("%s/%d", nsxGroupsPath(d.GetInt("network_server_id")), d.GetID())
+	if err := n.api.do(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	group := resp.Group
+	criteria := make([]map[string]interface{}, 0, len(group.Config.Criteria))
+	for _, c := range group.Config.Criteria {
+		criteria = append(criteria, map[string]interface{}{
+			"key":      c.Key,
+			"operator": c.Operator,
+			"value":    c.Value,
+		})
+	}
+	d.SetString("name", group.Name)
+	d.SetString("description", group.Description)
+	d.SetString("path", group.ExternalID)
+	d.Set("members", group.Config.Members)
+	d.Set("ip_addresses", group.Config.IPAddresses)
+	d.Set("criteria", criteria)
+
+	return d.Error()
+}
+
+func (n *nsxSecurityGroup) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, n.rClient.Client)
+	serverID, err := n.cache.getNsxNetworkServerID(ctx, n.rClient)
+	if err != nil {
+		return err
+	}
+	defer n.cache.invalidate(nsxGroupsPath(serverID))
+
+	var resp nsxSecurityGroupResp
+	err = n.api.do(ctx, http.MethodPost, nsxGroupsPath(serverID), nil,
+		nsxSecurityGroupBody{Group: n.getGroup(d)}, &resp)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating NSX security group")
+	}
+	id := resp.ID
+	if id == 0 {
+		id = resp.Group.ID
+	}
+	d.Set("network_server_id", serverID)
+	d.SetID(id)
+
+	return d.Error()
+}
+
+func (n *nsxSecurityGroup) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer n.cache.invalidate(nsxGroupsPath(d.GetInt("network_server_id")))
+	setMeta(meta, n.rClient.Client)
+	var resp models.SuccessOrErrorMessage
+	path := fmt.Sprintf("%s/%d", nsxGroupsPath(d.GetInt("network_server_id")), d.GetID())
+	if err := n.api.do(ctx, http.MethodPut, path, nil, nsxSecurityGroupBody{Group: n.getGroup(d)}, &resp); err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating NSX security group")
+	}
+
+	return nil
+}
+
+func (n *nsxSecurityGroup) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer n.cache.invalidate(nsxGroupsPath(d.GetInt("network_server_id")))
+	setMeta(meta, n.rClient.Client)
+	var resp models.SuccessOrErrorMessage
+	path := fmt.Sprintf("%s/%d", nsxGroupsPath(d.GetInt("network_server_id")), d.GetID())
+	if err := n.api.do(ctx, http.MethodDelete, path, nil, nil, &resp); err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("got success = 'false' while deleting NSX security group, error: %s", resp.Msg)
+	}
+
+	return nil
+}
+
+func (n *nsxSecurityGroup) getGroup(d *utils.Data) nsxGroup {
+	var criteria []nsxGroupCriterion
+	for i := range d.GetListMap("criteria") {
+		path := fmt.Sprintf("criteria.%d.", i)
+		criteria = append(criteria, nsxGroupCriterion{
+			MemberType: nsxGroupMemberTypeVM,
+			Key:        d.GetString(path + "key"),
+			Operator:   d.GetString(path + "operator"),
+			Value:      d.GetString(path + "value"),
+		})
+	}
+
+	return nsxGroup{
+		Name:        d.GetString("name"),
+		Description: d.GetString("description"),
+		Config: nsxGroupConfig{
+			Members:     d.GetStringList("members"),
+			IPAddresses: d.GetStringList("ip_addresses"),
+			Criteria:    criteria,
+		},
+	}
+}
@@ -0,0 +1,75 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"strconv"
+)
+
+// pageFetcher fetches a page of a CMP list API. params contains the offset
+// and max query params along with the params of the caller. total is the
+// total number of items as returned in the meta of the response, or 0 if
+// the API does not return meta.
+type pageFetcher[T any] func(ctx context.Context, params map[string]string) (page []T, total int, err error)
+
+// paginate walks all the pages of a CMP list API using offset and max query
+// params, and calls yield for each item. If yield returns false, the
+// iteration stops and the remaining pages are not fetched. pageSize less
+// than 1 uses defaultPageSize.
+//
+// CMP returns only the first page if max is not set, and max=-1 is not
+// supported by all the APIs, so list APIs should always be called using
+// paginate.
+func paginate[T any](
+	ctx context.Context,
+	pageSize int,
+	params map[string]string,
+	fetch pageFetcher[T],
+	yield func(item T) bool,
+) error {
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	queryParams := make(map[string]string, len(params)+2)
+	for k, v := range params {
+		queryParams[k] = v
+	}
+	queryParams[maxKey] = strconv.Itoa(pageSize)
+
+	for offset := 0; ; {
+		queryParams[offsetKey] = strconv.Itoa(offset)
+		page, total, err := fetch(ctx, queryParams)
+		if err != nil {
+			return err
+		}
+		for _, item := range page {
+			if !yield(item) {
+				return nil
+			}
+		}
+		offset += len(page)
+
+		// a partial page denotes the last page, if the API does not return meta
+		if len(page) == 0 || (total > 0 && offset >= total) || (total == 0 && len(page) < pageSize) {
+			return nil
+		}
+	}
+}
+
+// listAll returns the items of all the pages of a CMP list API
+func listAll[T any](
+	ctx context.Context,
+	pageSize int,
+	params map[string]string,
+	fetch pageFetcher[T],
+) ([]T, error) {
+	var items []T
+	err := paginate(ctx, pageSize, params, fetch, func(item T) bool {
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
@@ -0,0 +1,115 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// testPageFetcher returns a pageFetcher over items, which records the offsets
+// of the fetched pages. total is returned as meta if withMeta is true.
+func testPageFetcher(items []int, withMeta bool, offsets *[]int) pageFetcher[int] {
+	return func(ctx context.Context, params map[string]string) ([]int, int, error) {
+		offset, _ := strconv.Atoi(params[offsetKey])
+		max, _ := strconv.Atoi(params[maxKey])
+		*offsets = append(*offsets, offset)
+		end := offset + max
+		if end > len(items) {
+			end = len(items)
+		}
+		total := 0
+		if withMeta {
+			total = len(items)
+		}
+		if offset >= len(items) {
+			return nil, total, nil
+		}
+
+		return items[offset:end], total, nil
+	}
+}
+
+func TestListAll(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	tests := []struct {
+		name        string
+		pageSize    int
+		withMeta    bool
+		items       []int
+		wantOffsets []int
+	}{
+		{
+			name:        "Test case 1: partial last page without meta",
+			pageSize:    2,
+			items:       items,
+			wantOffsets: []int{0, 2, 4},
+		},
+		{
+			name:        "Test case 2: full last page without meta",
+			pageSize:    5,
+			items:       items,
+			wantOffsets: []int{0, 5},
+		},
+		{
+			name:        "Test case 3: full last page with meta",
+			pageSize:    5,
+			withMeta:    true,
+			items:       items,
+			wantOffsets: []int{0},
+		},
+		{
+			name:        "Test case 4: default page size",
+			pageSize:    0,
+			items:       items,
+			wantOffsets: []int{0},
+		},
+		{
+			name:        "Test case 5: no items",
+			pageSize:    2,
+			items:       nil,
+			wantOffsets: []int{0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var offsets []int
+			got, err := listAll(context.Background(), tt.pageSize, nil, testPageFetcher(tt.items, tt.withMeta, &offsets))
+			if err != nil {
+				t.Fatalf("listAll() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.items) {
+				t.Errorf("listAll() = %v, want %v", got, tt.items)
+			}
+			if !reflect.DeepEqual(offsets, tt.wantOffsets) {
+				t.Errorf("fetched offsets = %v, want %v", offsets, tt.wantOffsets)
+			}
+		})
+	}
+}
+
+func TestPaginateParams(t *testing.T) {
+	params := map[string]string{nameKey: "web"}
+	fetchErr := errors.New("fetch failed")
+	var got map[string]string
+	err := paginate(context.Background(), 10, params,
+		func(ctx context.Context, p map[string]string) ([]int, int, error) {
+			got = p
+
+			return nil, 0, fetchErr
+		},
+		func(item int) bool { return true })
+	if !errors.Is(err, fetchErr) {
+		t.Errorf("paginate() error = %v, want %v", err, fetchErr)
+	}
+	want := map[string]string{nameKey: "web", maxKey: "10", offsetKey: "0"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("params = %v, want %v", got, want)
+	}
+	if len(params) != 1 {
+		t.Errorf("params of the caller are modified: %v", params)
+	}
+}
@@ -1,44 +1,108 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
-	"fmt"
-	"log"
 
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 )
 
-type plan struct {
-	pClient *client.PlansAPIService
+func planCatalogue(api *cmpAPI) catalogue[models.ServicePlanResponse] {
+	return catalogue[models.ServicePlanResponse]{
+		api:          api,
+		kind:         "plan",
+		path:         func(d *utils.Data) string { return "service-plans" },
+		listKey:      "servicePlans",
+		itemKey:      "servicePlan",
+		nameQuery:    true,
+		queryFilters: map[string]string{"provision_type": provisionTypeKey},
+		item: func(p models.ServicePlanResponse) (int, string, map[string]interface{}) {
+			return p.ID, p.Name, planAttributes(p)
+		},
+		dateCreated: func(p models.ServicePlanResponse) string { return p.DateCreated },
+	}
 }
 
-func newPlan(pClient *client.PlansAPIService) *plan {
-	return &plan{pClient: pClient}
+func newPlan(api *cmpAPI) *catalogueLookup[models.ServicePlanResponse] {
+	return &catalogueLookup[models.ServicePlanResponse]{
+		catalogue: planCatalogue(api),
+		params: func(d *utils.Data) map[string]string {
+			provisionType := d.GetString("provision_type")
+			if provisionType == "" {
+				provisionType = vmware
+			}
+
+			return map[string]string{provisionTypeKey: provisionType}
+		},
+		filter: planSizeFilter,
+		order:  planSizeOrder,
+	}
 }
 
-func (n *plan) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, n.pClient.Client)
-	log.Printf("[DEBUG] Get plan")
+func newPlanList(api *cmpAPI) *catalogueList[models.ServicePlanResponse] {
+	return &catalogueList[models.ServicePlanResponse]{
+		catalogue: planCatalogue(api),
+		attribute: "plans",
+		params: func(d *utils.Data) map[string]string {
+			if provisionType := d.GetString("provision_type"); provisionType != "" {
+				return map[string]string{provisionTypeKey: provisionType}
+			}
 
-	name := d.GetString("name")
-	// Pre check
-	if err := d.Error(); err != nil {
-		return err
+			return nil
+		},
+		filter: planSizeFilter,
 	}
-	plans, err := n.pClient.GetAllServicePlans(ctx, map[string]string{
-		provisionTypeKey: vmware,
-		nameKey:          name,
-	})
-	if err != nil {
-		return err
+}
+
+// planSize holds the sizing requirements of the plan data sources
+type planSize struct {
+	cores   int
+	memory  int64
+	storage int64
+}
+
+func getPlanSize(d *utils.Data) planSize {
+	return planSize{
+		cores:   d.GetInt("min_cores"),
+		memory:  int64(d.GetInt("min_memory_gb")) * bytesPerGB,
+		storage: int64(d.GetInt("min_storage_gb")) * bytesPerGB,
 	}
-	if len(plans.ServicePlansResponse) != 1 {
-		return fmt.Errorf(errExactMatch, "plan")
+}
+
+func (s planSize) isSet() bool {
+	return s.cores > 0 || s.memory > 0 || s.storage > 0
+}
+
+// planSizeFilter matches the plans which satisfy all the sizing requirements
+func planSizeFilter(_ context.Context, d *utils.Data) (func(p models.ServicePlanResponse) bool, error) {
+	size := getPlanSize(d)
+
+	return func(p models.ServicePlanResponse) bool {
+		return p.MaxCores >= size.cores && p.MaxMemory >= size.memory && p.MaxStorage >= size.storage
+	}, d.Error()
+}
+
+// planSizeOrder orders the plans from the smallest to the largest, so the
+// smallest plan satisfying the sizing requirements is selected. Plans are
+// compared by cores, then memory and then storage.
+func planSizeOrder(d *utils.Data) func(a, b models.ServicePlanResponse) bool {
+	if !getPlanSize(d).isSet() {
+		return nil
 	}
-	d.SetID(plans.ServicePlansResponse[0].ID)
 
-	return d.Error()
+	return func(a, b models.ServicePlanResponse) bool {
+		if a.MaxCores != b.MaxCores {
+			return a.MaxCores < b.MaxCores
+		}
+		if a.MaxMemory != b.MaxMemory {
+			return a.MaxMemory < b.MaxMemory
+		}
+		if a.MaxStorage != b.MaxStorage {
+			return a.MaxStorage < b.MaxStorage
+		}
+
+		return a.SortOrder < b.SortOrder
+	}
 }
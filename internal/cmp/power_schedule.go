@@ -1,45 +1,36 @@
-// // (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// // (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
-	"context"
-	"fmt"
-	"log"
-
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 )
 
-type powerSchedule struct {
-	pClient *client.PowerSchedulesAPIService
+func powerScheduleCatalogue(api *cmpAPI) catalogue[models.GetAllPowerSchedulesSchedules] {
+	return catalogue[models.GetAllPowerSchedulesSchedules]{
+		api:       api,
+		kind:      "power schedule",
+		path:      func(d *utils.Data) string { return "power-schedules" },
+		listKey:   "schedules",
+		itemKey:   "schedule",
+		nameQuery: true,
+		item: func(p models.GetAllPowerSchedulesSchedules) (int, string, map[string]interface{}) {
+			return p.ID, p.Name, powerScheduleAttributes(p)
+		},
+		dateCreated: func(p models.GetAllPowerSchedulesSchedules) string { return p.Datecreated },
+	}
 }
 
-func newPowerSchedule(powerScheduleClient *client.PowerSchedulesAPIService) *powerSchedule {
-	return &powerSchedule{
-		pClient: powerScheduleClient,
+func newPowerSchedule(api *cmpAPI) *catalogueLookup[models.GetAllPowerSchedulesSchedules] {
+	return &catalogueLookup[models.GetAllPowerSchedulesSchedules]{
+		catalogue: powerScheduleCatalogue(api),
 	}
 }
 
-func (c *powerSchedule) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, c.pClient.Client)
-	log.Printf("[DEBUG] Get Power Schedule")
-
-	name := d.GetString("name")
-	if err := d.Error(); err != nil {
-		return err
+func newPowerScheduleList(api *cmpAPI) *catalogueList[models.GetAllPowerSchedulesSchedules] {
+	return &catalogueList[models.GetAllPowerSchedulesSchedules]{
+		catalogue: powerScheduleCatalogue(api),
+		attribute: "power_schedules",
 	}
-	powerSchedule, err := c.pClient.GetAllPowerSchedules(ctx, map[string]string{
-		nameKey: name,
-	})
-	if err != nil {
-		return err
-	}
-	if len(powerSchedule.Schedules) != 1 {
-		return fmt.Errorf(errExactMatch, "powerSchedules")
-	}
-	d.SetID(powerSchedule.Schedules[0].ID)
-
-	// post check
-	return d.Error()
 }
@@ -3,53 +3,33 @@
 package cmp
 
 import (
-	"context"
 	"fmt"
-	"log"
 
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 )
 
-type resourcePool struct {
-	rClient *client.CloudsAPIService
-}
-
-func newResourcePool(rClient *client.CloudsAPIService) *resourcePool {
-	return &resourcePool{rClient: rClient}
-}
-
-func (n *resourcePool) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, n.rClient.Client)
-	log.Printf("[DEBUG] Get ResourcePool")
-
-	name := d.GetString("name")
-	cloudID := d.GetInt("cloud_id")
-	// Pre check
-	if err := d.Error(); err != nil {
-		return err
-	}
-
-	flag := false
-	resourcePools, err := n.rClient.GetAllCloudResourcePools(ctx, cloudID, map[string]string{
-		maxKey: "-1",
-	})
-	if err != nil {
-		return err
-	}
-
-	for i, r := range resourcePools.ResourcePools {
-		if r.Name == name {
-			flag = true
-			d.SetID(resourcePools.ResourcePools[i].ID)
-
-			break
-		}
-	}
-	if !flag {
-		return fmt.Errorf(errExactMatch, "resource pool")
+func newResourcePool(api *cmpAPI) *catalogueLookup[models.ResourcePoolRespBody] {
+	return &catalogueLookup[models.ResourcePoolRespBody]{
+		catalogue: catalogue[models.ResourcePoolRespBody]{
+			api:  api,
+			kind: "resource pool",
+			path: func(d *utils.Data) string {
+				return fmt.Sprintf("zones/%d/resource-pools", d.GetInt("cloud_id"))
+			},
+			listKey: "resourcePools",
+			itemKey: "resourcePool",
+			item: func(r models.ResourcePoolRespBody) (int, string, map[string]interface{}) {
+				return r.ID, r.Name, map[string]interface{}{
+					"type":         r.Type,
+					"external_id":  r.Externalid,
+					"parent_id":    r.Parent.ID,
+					"default_pool": r.Defaultpool,
+					"active":       r.Active,
+					"status":       r.Status,
+					"visibility":   r.Visibility,
+				}
+			},
+		},
 	}
-
-	// post check
-	return d.Error()
 }
@@ -0,0 +1,113 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
+)
+
+// resDNSRecord manages the DNS records of network domains. Records are
+// created by CMP on the DNS integration of the domain.
+type resDNSRecord struct {
+	api *cmpAPI
+}
+
+type dnsRecordBody struct {
+	NetworkDomainRecord dnsRecord `json:"networkDomainRecord"`
+}
+
+type dnsRecord struct {
+	ID      int    `json:"id,omitempty"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Content string `json:"content"`
+	TTL     int    `json:"ttl,omitempty"`
+	Fqdn    string `json:"fqdn,omitempty"`
+}
+
+type dnsRecordResp struct {
+	Success             bool      `json:"success"`
+	NetworkDomainRecord dnsRecord `json:"networkDomainRecord"`
+}
+
+func newResDNSRecord(api *cmpAPI) *resDNSRecord {
+	return &resDNSRecord{api: api}
+}
+
+func (r *resDNSRecord) path(domainID int) string {
+	return fmt.Sprintf("%s/%s", networkDomainPath(domainID), networkDomainRecordsPath)
+}
+
+func (r *resDNSRecord) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.api.client)
+	var resp dnsRecordResp
+	err := r.api.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", r.path(d.GetInt("domain_id")), d.GetID()), nil,
+		nil, &resp)
+	if err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	record := resp.NetworkDomainRecord
+	d.SetString("name", record.Name)
+	d.SetString("type", record.Type)
+	d.SetString("content", record.Content)
+	d.Set("ttl", record.TTL)
+	d.SetString("fqdn", record.Fqdn)
+
+	return d.Error()
+}
+
+func (r *resDNSRecord) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.api.client)
+	req := dnsRecord{
+		Name:    d.GetString("name"),
+		Type:    d.GetString("type"),
+		Content: d.GetString("content"),
+		TTL:     d.GetInt("ttl"),
+	}
+
+	var resp dnsRecordResp
+	err := r.api.do(ctx, http.MethodPost, r.path(d.GetInt("domain_id")), nil,
+		dnsRecordBody{NetworkDomainRecord: req}, &resp)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating DNS record")
+	}
+	d.SetID(resp.NetworkDomainRecord.ID)
+
+	return d.Error()
+}
+
+// Update is not supported, all the attributes of DNS record are ForceNew
+func (r *resDNSRecord) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	return nil
+}
+
+func (r *resDNSRecord) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.api.client)
+	var resp models.SuccessOrErrorMessage
+	err := r.api.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", r.path(d.GetInt("domain_id")), d.GetID()), nil,
+		nil, &resp)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("got success = 'false' while deleting DNS record, error: %s", resp.Msg)
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
+)
+
+// resIPAddress reserves IP addresses of network pools. The sdk does not
+// support pool IP addresses, so the reservations are managed using cmpAPI.
+type resIPAddress struct {
+	api *cmpAPI
+}
+
+type poolIPBody struct {
+	NetworkPoolIP poolIP `json:"networkPoolIp"`
+}
+
+type poolIP struct {
+	ID            int           `json:"id,omitempty"`
+	IPAddress     string        `json:"ipAddress,omitempty"`
+	Hostname      string        `json:"hostname"`
+	NetworkDomain *poolIPDomain `json:"networkDomain,omitempty"`
+}
+
+type poolIPDomain struct {
+	ID   int    `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+type poolIPResp struct {
+	Success       bool   `json:"success"`
+	NetworkPoolIP poolIP `json:"networkPoolIp"`
+}
+
+func newResIPAddress(api *cmpAPI) *resIPAddress {
+	return &resIPAddress{api: api}
+}
+
+func (r *resIPAddress) path(poolID int) string {
+	return fmt.Sprintf("%s/%s", networkPoolPath(poolID), networkPoolIPsPath)
+}
+
+func (r *resIPAddress) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.api.client)
+	poolID := d.GetInt("pool_id")
+	var resp poolIPResp
+	err := r.api.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", r.path(poolID), d.GetID()), nil, nil, &resp)
+	if err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	ip := resp.NetworkPoolIP
+	domainID := 0
+	dnsDomain := ""
+	if ip.NetworkDomain != nil {
+		domainID = ip.NetworkDomain.ID
+		dnsDomain = ip.NetworkDomain.Name
+	} else {
+		// records are created in the DNS domain of the pool, if the
+		// reservation does not have a domain
+		var poolResp ipPoolResp
+		if err := r.api.do(ctx, http.MethodGet, networkPoolPath(poolID), nil, nil, &poolResp); err != nil {
+			return err
+		}
+		dnsDomain = poolResp.NetworkPool.DNSDomain
+	}
+	dnsRecord := ip.Hostname
+	if dnsDomain != "" && ip.Hostname != "" {
+		dnsRecord = ip.Hostname + "." + dnsDomain
+	}
+
+	d.SetString("ip_address", ip.IPAddress)
+	d.SetString("hostname", ip.Hostname)
+	d.Set("domain_id", domainID)
+	d.SetString("dns_record", dnsRecord)
+
+	return d.Error()
+}
+
+// Create reserves the given IP address. If ip_address is not set, the
+// address is allocated by CMP, so that parallel reservations on the same pool
+// do not get the same address.
+func (r *resIPAddress) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer r.api.cache.invalidate(networkPoolsCachePath)
+	setMeta(meta, r.api.client)
+	req := r.getPoolIP(d)
+	req.IPAddress = d.GetString("ip_address")
+
+	var resp poolIPResp
+	err := r.api.do(ctx, http.MethodPost, r.path(d.GetInt("pool_id")), nil, poolIPBody{NetworkPoolIP: req}, &resp)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "reserving IP address")
+	}
+	d.SetID(resp.NetworkPoolIP.ID)
+
+	return d.Error()
+}
+
+func (r *resIPAddress) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer r.api.cache.invalidate(networkPoolsCachePath)
+	setMeta(meta, r.api.client)
+	req := r.getPoolIP(d)
+	req.IPAddress = d.GetString("ip_address")
+
+	var resp poolIPResp
+	err := r.api.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d", r.path(d.GetInt("pool_id")), d.GetID()), nil,
+		poolIPBody{NetworkPoolIP: req}, &resp)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating IP address reservation")
+	}
+
+	return d.Error()
+}
+
+// Delete releases the reservation of the IP address
+func (r *resIPAddress) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer r.api.cache.invalidate(networkPoolsCachePath)
+	setMeta(meta, r.api.client)
+	var resp models.SuccessOrErrorMessage
+	err := r.api.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", r.path(d.GetInt("pool_id")), d.GetID()), nil,
+		nil, &resp)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("got success = 'false' while releasing IP address, error: %s", resp.Msg)
+	}
+
+	return nil
+}
+
+func (r *resIPAddress) getPoolIP(d *utils.Data) poolIP {
+	req := poolIP{
+		Hostname: d.GetString("hostname"),
+	}
+	if domainID := d.GetInt("domain_id"); domainID != 0 {
+		req.NetworkDomain = &poolIPDomain{ID: domainID}
+	}
+
+	return req
+}
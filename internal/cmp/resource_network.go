@@ -4,11 +4,14 @@ package cmp
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/tshihad/tftags"
@@ -17,12 +20,59 @@ import (
 type resNetwork struct {
 	nClient *client.NetworksAPIService
 	rClient *client.RouterAPIService
+	api     *cmpAPI
+	cache   *cache
 }
 
-func newResNetwork(nclient *client.NetworksAPIService, rclient *client.RouterAPIService) *resNetwork {
+// networkIPv6 is the IPv6 configuration of dual stack networks. IPv6 fields
+// are not part of the sdk models, hence networks are read, created and updated
+// using cmpAPI.
+type networkIPv6 struct {
+	CidrIPv6         string            `json:"cidrIPv6"`
+	GatewayIPv6      string            `json:"gatewayIPv6"`
+	DNSPrimaryIPv6   string            `json:"dnsPrimaryIPv6"`
+	DNSSecondaryIPv6 string            `json:"dnsSecondaryIPv6"`
+	Config           networkConfigIPv6 `json:"config"`
+}
+
+type networkConfigIPv6 struct {
+	SubnetDhcpServerAddressIPv6 string `json:"subnetDhcpServerAddressIPv6"`
+	DhcpRangeIPv6               string `json:"dhcpRangeIPv6"`
+}
+
+type networkIPv6Body struct {
+	Network networkIPv6 `json:"network"`
+}
+
+// networkRequestBody is the create and update request of a network along
+// with the IPv6 configurations. IPv6 fields are nil if not configured, so that
+// those are not sent to CMP versions without IPv6 support.
+type networkRequestBody struct {
+	Network             networkRequest              `json:"network"`
+	ResourcePermissions models.NetworkResPermission `json:"resourcePermissions,omitempty"`
+}
+
+type networkRequest struct {
+	models.CreateNetwork
+	CidrIPv6         *string       `json:"cidrIPv6,omitempty"`
+	GatewayIPv6      *string       `json:"gatewayIPv6,omitempty"`
+	DNSPrimaryIPv6   *string       `json:"dnsPrimaryIPv6,omitempty"`
+	DNSSecondaryIPv6 *string       `json:"dnsSecondaryIPv6,omitempty"`
+	Config           networkConfig `json:"config"`
+}
+
+type networkConfig struct {
+	models.CreateNetworkConfig
+	SubnetDhcpServerAddressIPv6 *string `json:"subnetDhcpServerAddressIPv6,omitempty"`
+	DhcpRangeIPv6               *string `json:"dhcpRangeIPv6,omitempty"`
+}
+
+func newResNetwork(nclient *client.NetworksAPIService, rclient *client.RouterAPIService, api *cmpAPI) *resNetwork {
 	return &resNetwork{
 		nClient: nclient,
 		rClient: rclient,
+		api:     api,
+		cache:   api.cache,
 	}
 }
 
@@ -34,17 +84,29 @@ func (r *resNetwork) Read(ctx context.Context, d *utils.Data, meta interface{})
 		return err
 	}
 
-	// Get network details with ID
-	getNetwork, err := r.nClient.GetSpecificNetwork(ctx, tfNetwork.ID)
+	// Get network details with ID. Network is read using cmpAPI, since the sdk
+	// model does not have the IPv6 fields
+	var body json.RawMessage
+	err := r.api.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", consts.NetworksPath, tfNetwork.ID), nil, nil, &body)
 	if err != nil {
 		return err
 	}
+	var getNetwork models.GetSpecificNetworkBody
+	if err := json.Unmarshal(body, &getNetwork); err != nil {
+		return err
+	}
+	var getIPv6 networkIPv6Body
+	if err := json.Unmarshal(body, &getIPv6); err != nil {
+		return err
+	}
+	r.setIPv6(d, getIPv6.Network)
 
 	return tftags.Set(d, getNetwork.Network)
 }
 
 func (r *resNetwork) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
-	nsxType, err := GetNsxTypeFromCMP(ctx, r.rClient.Client)
+	defer r.cache.invalidate(networksCachePath)
+	nsxType, err := r.cache.getNsxType(ctx, r.rClient.Client)
 	if err != nil {
 		return err
 	}
@@ -68,7 +130,7 @@ func (r *resNetwork) Create(ctx context.Context, d *utils.Data, meta interface{}
 	// Get network server ID for nsx-t
 	serverRetry := utils.CustomRetry{}
 	serverRetry.RetryParallel(ctx, meta, func(ctx context.Context) (interface{}, error) {
-		return r.rClient.GetNetworkServices(ctx, nil)
+		return r.cache.getNetworkServices(ctx, r.rClient)
 	})
 	typeResp, err := typeRetry.Wait()
 	if err != nil {
@@ -100,10 +162,13 @@ func (r *resNetwork) Create(ctx context.Context, d *utils.Data, meta interface{}
 	}
 
 	// Create network
-	createResp, err := r.nClient.CreateNetwork(ctx, models.CreateNetworkRequest{
-		Network:             createReq,
-		ResourcePermissions: createReq.ResourcePermissions,
-	})
+	capabilities, err := r.cache.getCapabilities(ctx, r.rClient.Client)
+	if err != nil {
+		return err
+	}
+	var createResp models.CreateNetworkResponse
+	err = r.api.do(ctx, http.MethodPost, consts.NetworksPath, nil,
+		r.networkRequestBody(d, createReq, capabilities), &createResp)
 	if err != nil {
 		return err
 	}
@@ -161,6 +226,7 @@ func (r *resNetwork) Create(ctx context.Context, d *utils.Data, meta interface{}
 }
 
 func (r *resNetwork) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer r.cache.invalidate(networksCachePath)
 	setMeta(meta, r.rClient.Client)
 	var networkReq models.CreateNetwork
 	if err := tftags.Get(d, &networkReq); err != nil {
@@ -172,10 +238,13 @@ func (r *resNetwork) Update(ctx context.Context, d *utils.Data, meta interface{}
 		return err
 	}
 
-	updateResp, err := r.nClient.UpdateNetwork(ctx, networkReq.ID, models.CreateNetworkRequest{
-		Network:             networkReq,
-		ResourcePermissions: networkReq.ResourcePermissions,
-	})
+	capabilities, err := r.cache.getCapabilities(ctx, r.rClient.Client)
+	if err != nil {
+		return err
+	}
+	var updateResp models.SuccessOrErrorMessage
+	err = r.api.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d", consts.NetworksPath, networkReq.ID), nil,
+		r.networkRequestBody(d, networkReq, capabilities), &updateResp)
 	if err != nil {
 		return err
 	}
@@ -189,6 +258,7 @@ func (r *resNetwork) Update(ctx context.Context, d *utils.Data, meta interface{}
 }
 
 func (r *resNetwork) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer r.cache.invalidate(networksCachePath)
 	setMeta(meta, r.rClient.Client)
 	networkID := d.GetID()
 	// wait until deleted
@@ -237,3 +307,61 @@ func (r *resNetwork) networkRequest(createReq *models.CreateNetwork) error {
 
 	return nil
 }
+
+// networkRequestBody returns the create and update request body along with
+// the IPv6 configurations of the network. IPv6 fields are sent if configured
+// or changed, so that the removed fields are cleared. Network pool is sent as
+// pool of networkPool if supported, as cmp-go-sdk does.
+func (r *resNetwork) networkRequestBody(
+	d *utils.Data,
+	req models.CreateNetwork,
+	capabilities *Capabilities,
+) networkRequestBody {
+	if capabilities.Supports(CapNetworkPoolObject) && req.NetworkPool != nil {
+		req.NetworkPool.Pool = req.PoolID
+		req.PoolID = 0
+	}
+	body := networkRequestBody{
+		Network: networkRequest{
+			CreateNetwork:    req,
+			CidrIPv6:         networkIPv6Field(d, "cidr_ipv6"),
+			GatewayIPv6:      networkIPv6Field(d, "gateway_ipv6"),
+			DNSPrimaryIPv6:   networkIPv6Field(d, "primary_dns_ipv6"),
+			DNSSecondaryIPv6: networkIPv6Field(d, "secondary_dns_ipv6"),
+			Config:           networkConfig{CreateNetworkConfig: req.Config},
+		},
+		ResourcePermissions: req.ResourcePermissions,
+	}
+	if req.TfDhcpNetwork != nil {
+		body.Network.Config.DhcpRangeIPv6 = networkIPv6Field(d, "dhcp_network.0.dhcp_range_ipv6")
+		body.Network.Config.SubnetDhcpServerAddressIPv6 = networkIPv6Field(d,
+			"dhcp_network.0.dhcp_server_address_ipv6")
+	}
+
+	return body
+}
+
+// networkIPv6Field returns the IPv6 field of the request, which is nil if the
+// field is neither configured nor changed
+func networkIPv6Field(d *utils.Data, key string) *string {
+	v := d.GetString(key)
+	if v == "" && !d.HasChanged(key) {
+		return nil
+	}
+
+	return &v
+}
+
+// setIPv6 sets the IPv6 configurations of the network. DHCP configurations
+// are set only if the network is a DHCP network in the state.
+func (r *resNetwork) setIPv6(d *utils.Data, ipv6 networkIPv6) {
+	d.SetString("cidr_ipv6", ipv6.CidrIPv6)
+	d.SetString("gateway_ipv6", ipv6.GatewayIPv6)
+	d.SetString("primary_dns_ipv6", ipv6.DNSPrimaryIPv6)
+	d.SetString("secondary_dns_ipv6", ipv6.DNSSecondaryIPv6)
+	if dhcpNetwork := d.GetListMap("dhcp_network"); len(dhcpNetwork) == 1 {
+		dhcpNetwork[0]["dhcp_range_ipv6"] = ipv6.Config.DhcpRangeIPv6
+		dhcpNetwork[0]["dhcp_server_address_ipv6"] = ipv6.Config.SubnetDhcpServerAddressIPv6
+		d.Set("dhcp_network", dhcpNetwork)
+	}
+}
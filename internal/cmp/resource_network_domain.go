@@ -0,0 +1,152 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
+)
+
+// resNetworkDomain manages network domains. The sdk supports only reading
+// the domains, so the domains are managed using cmpAPI.
+type resNetworkDomain struct {
+	api *cmpAPI
+}
+
+type networkDomainBody struct {
+	NetworkDomain networkDomain `json:"networkDomain"`
+}
+
+type networkDomain struct {
+	ID               int    `json:"id,omitempty"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	Active           bool   `json:"active"`
+	Visibility       string `json:"visibility,omitempty"`
+	PublicZone       bool   `json:"publicZone"`
+	DomainController bool   `json:"domainController"`
+	DomainUsername   string `json:"domainUsername,omitempty"`
+	DomainPassword   string `json:"domainPassword,omitempty"`
+	DCServer         string `json:"dcServer,omitempty"`
+	OUPath           string `json:"ouPath,omitempty"`
+}
+
+type networkDomainResp struct {
+	Success       bool          `json:"success"`
+	NetworkDomain networkDomain `json:"networkDomain"`
+}
+
+func newResNetworkDomain(api *cmpAPI) *resNetworkDomain {
+	return &resNetworkDomain{api: api}
+}
+
+func networkDomainPath(id int) string {
+	return fmt.Sprintf("%s/%s/%d", consts.NetworksPath, consts.DomainPath, id)
+}
+
+func (n *resNetworkDomain) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, n.api.client)
+	var resp networkDomainResp
+	if err := n.api.do(ctx, http.MethodGet, networkDomainPath(d.GetID()), nil, nil, &resp); err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	domain := resp.NetworkDomain
+	d.SetString("name", domain.Name)
+	d.SetString("description", domain.Description)
+	d.Set("active", domain.Active)
+	d.SetString("visibility", domain.Visibility)
+	d.Set("public_zone", domain.PublicZone)
+	if domain.DomainController {
+		// password is not returned by CMP, hence retained from the state
+		domainJoin := map[string]interface{}{
+			"username":  domain.DomainUsername,
+			"password":  d.GetString("domain_join.0.password"),
+			"dc_server": domain.DCServer,
+			"ou_path":   domain.OUPath,
+		}
+		d.Set("domain_join", []map[string]interface{}{domainJoin})
+	} else {
+		d.Set("domain_join", []map[string]interface{}{})
+	}
+
+	return d.Error()
+}
+
+func (n *resNetworkDomain) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer n.api.cache.invalidate(networkDomainsCachePath, networksCachePath)
+	setMeta(meta, n.api.client)
+	var resp networkDomainResp
+	path := fmt.Sprintf("%s/%s", consts.NetworksPath, consts.DomainPath)
+	err := n.api.do(ctx, http.MethodPost, path, nil, networkDomainBody{NetworkDomain: n.getNetworkDomain(d)}, &resp)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating network domain")
+	}
+	d.SetID(resp.NetworkDomain.ID)
+
+	return d.Error()
+}
+
+func (n *resNetworkDomain) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer n.api.cache.invalidate(networkDomainsCachePath, networksCachePath)
+	setMeta(meta, n.api.client)
+	var resp networkDomainResp
+	err := n.api.do(ctx, http.MethodPut, networkDomainPath(d.GetID()), nil,
+		networkDomainBody{NetworkDomain: n.getNetworkDomain(d)}, &resp)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating network domain")
+	}
+
+	return d.Error()
+}
+
+func (n *resNetworkDomain) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer n.api.cache.invalidate(networkDomainsCachePath, networksCachePath)
+	setMeta(meta, n.api.client)
+	var resp models.SuccessOrErrorMessage
+	if err := n.api.do(ctx, http.MethodDelete, networkDomainPath(d.GetID()), nil, nil, &resp); err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("got success = 'false' while deleting network domain, error: %s", resp.Msg)
+	}
+
+	return nil
+}
+
+func (n *resNetworkDomain) getNetworkDomain(d *utils.Data) networkDomain {
+	domain := networkDomain{
+		Name:        d.GetString("name"),
+		Description: d.GetString("description"),
+		Active:      d.GetBool("active"),
+		Visibility:  d.GetString("visibility"),
+		PublicZone:  d.GetBool("public_zone"),
+	}
+	if domainJoin := d.GetListMap("domain_join"); len(domainJoin) == 1 {
+		domain.DomainController = true
+		domain.DomainUsername, _ = domainJoin[0]["username"].(string)
+		domain.DomainPassword, _ = domainJoin[0]["password"].(string)
+		domain.DCServer, _ = domainJoin[0]["dc_server"].(string)
+		domain.OUPath, _ = domainJoin[0]["ou_path"].(string)
+	}
+
+	return domain
+}
@@ -0,0 +1,190 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
+)
+
+// resNetworkPool manages IP pools of networks. The sdk supports only
+// listing the pools, so the pools are managed using cmpAPI.
+type resNetworkPool struct {
+	api *cmpAPI
+}
+
+type ipPoolBody struct {
+	NetworkPool ipPool `json:"networkPool"`
+}
+
+type ipPool struct {
+	ID          int           `json:"id,omitempty"`
+	Name        string        `json:"name"`
+	DisplayName string        `json:"displayName,omitempty"`
+	Type        *ipPoolType   `json:"type,omitempty"`
+	Gateway     string        `json:"gateway"`
+	DNSServers  []string      `json:"dnsServers"`
+	DNSDomain   string        `json:"dnsDomain"`
+	PtrDomain   string        `json:"ptrDomain"`
+	IPRanges    []ipPoolRange `json:"ipRanges"`
+	IPCount     int           `json:"ipCount,omitempty"`
+	FreeCount   int           `json:"freeCount,omitempty"`
+}
+
+type ipPoolType struct {
+	ID   int    `json:"id,omitempty"`
+	Code string `json:"code"`
+}
+
+type ipPoolRange struct {
+	ID           int    `json:"id,omitempty"`
+	StartAddress string `json:"startAddress"`
+	EndAddress   string `json:"endAddress"`
+	AddressCount int    `json:"addressCount,omitempty"`
+}
+
+type ipPoolResp struct {
+	Success     bool   `json:"success"`
+	NetworkPool ipPool `json:"networkPool"`
+}
+
+func newResNetworkPool(api *cmpAPI) *resNetworkPool {
+	return &resNetworkPool{api: api}
+}
+
+func networkPoolPath(id int) string {
+	return fmt.Sprintf("%s/%s/%d", consts.NetworksPath, consts.NetworkPoolPath, id)
+}
+
+func (n *resNetworkPool) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, n.api.client)
+	var resp ipPoolResp
+	if err := n.api.do(ctx, http.MethodGet, networkPoolPath(d.GetID()), nil, nil, &resp); err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	pool := resp.NetworkPool
+	ipRanges := make([]map[string]interface{}, 0, len(pool.IPRanges))
+	for _, r := range pool.IPRanges {
+		ipRanges = append(ipRanges, map[string]interface{}{
+			"id":            r.ID,
+			"start_address": r.StartAddress,
+			"end_address":   r.EndAddress,
+			"address_count": r.AddressCount,
+		})
+	}
+	typeCode := ""
+	if pool.Type != nil {
+		typeCode = pool.Type.Code
+	}
+
+	d.SetString("name", pool.Name)
+	d.SetString("display_name", pool.DisplayName)
+	d.SetString("type_code", typeCode)
+	d.SetString("gateway", pool.Gateway)
+	d.Set("dns_servers", pool.DNSServers)
+	d.SetString("dns_domain", pool.DNSDomain)
+	d.SetString("reverse_dns_zone", pool.PtrDomain)
+	d.Set("ip_ranges", ipRanges)
+	d.Set("ip_count", pool.IPCount)
+	d.Set("free_count", pool.FreeCount)
+
+	return d.Error()
+}
+
+func (n *resNetworkPool) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer n.api.cache.invalidate(networkPoolsCachePath, networksCachePath)
+	setMeta(meta, n.api.client)
+	pool := n.getNetworkPool(d)
+	pool.Type = &ipPoolType{Code: d.GetString("type_code")}
+
+	var resp ipPoolResp
+	path := fmt.Sprintf("%s/%s", consts.NetworksPath, consts.NetworkPoolPath)
+	if err := n.api.do(ctx, http.MethodPost, path, nil, ipPoolBody{NetworkPool: pool}, &resp); err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating network pool")
+	}
+	d.SetID(resp.NetworkPool.ID)
+
+	return d.Error()
+}
+
+// Update updates the pool along with its ranges. CMP replaces the ranges of
+// the pool with the ranges of the request.
+func (n *resNetworkPool) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer n.api.cache.invalidate(networkPoolsCachePath, networksCachePath)
+	setMeta(meta, n.api.client)
+	pool := n.getNetworkPool(d)
+
+	var resp ipPoolResp
+	if err := n.api.do(ctx, http.MethodPut, networkPoolPath(d.GetID()), nil, ipPoolBody{NetworkPool: pool}, &resp); err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating network pool")
+	}
+
+	return d.Error()
+}
+
+func (n *resNetworkPool) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer n.api.cache.invalidate(networkPoolsCachePath, networksCachePath)
+	setMeta(meta, n.api.client)
+	var resp models.SuccessOrErrorMessage
+	if err := n.api.do(ctx, http.MethodDelete, networkPoolPath(d.GetID()), nil, nil, &resp); err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("got success = 'false' while deleting network pool, error: %s", resp.Msg)
+	}
+
+	return nil
+}
+
+// getNetworkPool returns the request of the pool. IDs of the ranges are
+// taken from the state by matching the addresses, so that the unchanged
+// ranges are retained by CMP instead of being recreated.
+func (n *resNetworkPool) getNetworkPool(d *utils.Data) ipPool {
+	oldRanges, _ := d.GetChangedListMap("ip_ranges")
+	rangeIDs := make(map[string]int)
+	for _, r := range oldRanges {
+		start, _ := r["start_address"].(string)
+		end, _ := r["end_address"].(string)
+		id, _ := r["id"].(int)
+		rangeIDs[start+"-"+end] = id
+	}
+
+	pool := ipPool{
+		Name:        d.GetString("name"),
+		DisplayName: d.GetString("display_name"),
+		Gateway:     d.GetString("gateway"),
+		DNSServers:  d.GetStringList("dns_servers"),
+		DNSDomain:   d.GetString("dns_domain"),
+		PtrDomain:   d.GetString("reverse_dns_zone"),
+	}
+	for _, r := range d.GetListMap("ip_ranges") {
+		start, _ := r["start_address"].(string)
+		end, _ := r["end_address"].(string)
+		pool.IPRanges = append(pool.IPRanges, ipPoolRange{
+			ID:           rangeIDs[start+"-"+end],
+			StartAddress: start,
+			EndAddress:   end,
+		})
+	}
+
+	return pool
+}
@@ -0,0 +1,123 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testNetworkIPv6Schema() map[string]*schema.Schema {
+	stringSchema := func() *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Optional: true}
+	}
+
+	return map[string]*schema.Schema{
+		"cidr_ipv6":          stringSchema(),
+		"gateway_ipv6":       stringSchema(),
+		"primary_dns_ipv6":   stringSchema(),
+		"secondary_dns_ipv6": stringSchema(),
+		"dhcp_network": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"dhcp_range_ipv6":          stringSchema(),
+					"dhcp_server_address_ipv6": stringSchema(),
+				},
+			},
+		},
+	}
+}
+
+func TestNetworkRequestBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]interface{}
+		req     models.CreateNetwork
+		version string
+		want    map[string]interface{}
+	}{
+		{
+			name:    "Test case 1: IPv4 network",
+			config:  map[string]interface{}{},
+			req:     models.CreateNetwork{Name: "net", PoolID: 5},
+			version: "5.4.3",
+			want: map[string]interface{}{
+				"name": "net",
+				"pool": float64(5),
+			},
+		},
+		{
+			name: "Test case 2: dual stack DHCP network",
+			config: map[string]interface{}{
+				"cidr_ipv6":    "2001:db8::1/64",
+				"gateway_ipv6": "2001:db8::1",
+				"dhcp_network": []interface{}{map[string]interface{}{
+					"dhcp_range_ipv6": "2001:db8::10-2001:db8::20",
+				}},
+			},
+			req:     models.CreateNetwork{Name: "net", TfDhcpNetwork: &models.CreateDhcpNetwork{}},
+			version: "6.2.4",
+			want: map[string]interface{}{
+				"name":          "net",
+				"cidrIPv6":      "2001:db8::1/64",
+				"gatewayIPv6":   "2001:db8::1",
+				"dhcpRangeIPv6": "2001:db8::10-2001:db8::20",
+			},
+		},
+		{
+			name:    "Test case 3: network pool object",
+			config:  map[string]interface{}{},
+			req:     models.CreateNetwork{Name: "net", PoolID: 5, NetworkPool: &models.PoolModel{}},
+			version: "5.4.4",
+			want: map[string]interface{}{
+				"name":        "net",
+				"networkPool": map[string]interface{}{"pool": float64(5)},
+			},
+		},
+	}
+	keys := []string{
+		"name", "pool", "networkPool", "cidrIPv6", "gatewayIPv6", "dnsPrimaryIPv6", "dnsSecondaryIPv6",
+		"dhcpRangeIPv6", "subnetDhcpServerAddressIPv6",
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, err := ParseVersion(tt.version)
+			if err != nil {
+				t.Fatalf("ParseVersion() error = %v", err)
+			}
+			d := utils.NewData(schema.TestResourceDataRaw(t, testNetworkIPv6Schema(), tt.config))
+			body, err := json.Marshal((&resNetwork{}).networkRequestBody(d, tt.req, newCapabilities(version)))
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			var got struct {
+				Network map[string]interface{} `json:"network"`
+			}
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatalf("invalid request body %s: %v", body, err)
+			}
+			// config fields are compared along with the network fields
+			config, _ := got.Network["config"].(map[string]interface{})
+			for k, v := range config {
+				got.Network[k] = v
+			}
+			gotFields := make(map[string]interface{})
+			for _, k := range keys {
+				if v, ok := got.Network[k]; ok {
+					gotFields[k] = v
+				}
+			}
+			if !reflect.DeepEqual(gotFields, tt.want) {
+				t.Errorf("networkRequestBody() = %v, want %v", gotFields, tt.want)
+			}
+		})
+	}
+}
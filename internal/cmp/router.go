@@ -1,41 +1,58 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
 	"github.com/tshihad/tftags"
 )
 
 type router struct {
 	rClient *client.RouterAPIService
+	cache   *cache
 }
 
-func newRouter(routerClient *client.RouterAPIService) *router {
+func newRouter(routerClient *client.RouterAPIService, c *cache) *router {
 	return &router{
 		rClient: routerClient,
+		cache:   c,
 	}
 }
 
 func (r *router) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	var tfRouter models.GetNetworkRouter
-	if err := tftags.Get(d, &tfRouter); err != nil {
+	setMeta(meta, r.rClient.Client)
+	getRouter, err := r.rClient.GetSpecificRouter(ctx, d.GetID())
+	if err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
+
 		return err
 	}
-	getRouter, err := r.rClient.GetSpecificRouter(ctx, tfRouter.ID)
-	if err != nil {
+
+	networkRouter := getRouter.NetworkRouter
+	if err := tftags.Set(d, networkRouter); err != nil {
 		return err
 	}
+	d.SetString("name", networkRouter.Name)
+	d.Set("enable", networkRouter.Enabled)
+	d.Set("type_id", networkRouter.Type.ID)
+	d.Set("network_server_id", networkRouter.NetworkServer.ID)
 
-	return tftags.Set(d, getRouter.NetworkRouter)
+	return d.Error()
 }
 
 func (r *router) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer r.cache.invalidate(routersCachePath)
 	createReq := models.CreateRouterRequest{}
 	if err := tftags.Get(d, &createReq.NetworkRouter); err != nil {
 		return err
@@ -72,6 +89,7 @@ func (r *router) Create(ctx context.Context, d *utils.Data, meta interface{}) er
 }
 
 func (r *router) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer r.cache.invalidate(routersCachePath)
 	createReq := models.CreateRouterRequest{}
 	if err := tftags.Get(d, &createReq.NetworkRouter); err != nil {
 		return err
@@ -101,6 +119,7 @@ func (r *router) Update(ctx context.Context, d *utils.Data, meta interface{}) er
 }
 
 func (r *router) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	defer r.cache.invalidate(routersCachePath)
 	routerID := d.GetID()
 	_, err := r.rClient.DeleteRouter(ctx, routerID)
 	if err != nil {
@@ -111,7 +130,7 @@ func (r *router) Delete(ctx context.Context, d *utils.Data, meta interface{}) er
 }
 
 func (r *router) routerAlignRouterRequest(ctx context.Context, meta interface{}, routerReq *models.CreateRouterRequest) error {
-	nsxType, err := GetNsxTypeFromCMP(ctx, r.rClient.Client)
+	nsxType, err := r.cache.getNsxType(ctx, r.rClient.Client)
 	if err != nil {
 		return err
 	}
@@ -155,7 +174,7 @@ func (r *router) routerAlignRouterRequest(ctx context.Context, meta interface{},
 	// Get network service ID
 	nsRetry := utils.CustomRetry{}
 	nsRetry.RetryParallel(ctx, meta, func(ctx context.Context) (interface{}, error) {
-		return r.rClient.GetNetworkServices(ctx, nil)
+		return r.cache.getNetworkServices(ctx, r.rClient)
 	})
 	// Align Router Type
 	rtResp, err := rtRetry.Wait()
@@ -1,14 +1,17 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strconv"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
 	"github.com/tshihad/tftags"
 )
 
@@ -29,12 +32,50 @@ func (r *routerBgpNeighbor) Read(ctx context.Context, d *utils.Data, meta interf
 		return err
 	}
 
-	_, err := r.rClient.GetSpecificRouterBgpNeighbor(ctx, tfBgpNeighbor.RouterID, tfBgpNeighbor.ID)
+	resp, err := r.rClient.GetSpecificRouterBgpNeighbor(ctx, tfBgpNeighbor.RouterID, tfBgpNeighbor.ID)
 	if err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
+
 		return err
 	}
 
-	return tftags.Set(d, tfBgpNeighbor)
+	// set the attributes from CMP, so that the changes outside terraform
+	// are detected. Route filters are not returned by CMP, hence retained
+	// from the state
+	neighbor := resp.NetworkRouterBgpNeighbor
+	// remote AS is set as 0 if not set in CMP, so that the change is shown
+	// in the plan
+	remoteAs := 0
+	if neighbor.RemoteAs != "" {
+		var err error
+		if remoteAs, err = strconv.Atoi(neighbor.RemoteAs); err != nil {
+			return fmt.Errorf("invalid remote AS %s of the BGP neighbor: %w", neighbor.RemoteAs, err)
+		}
+	}
+	d.SetString("ip_address", neighbor.IPAddress)
+	d.Set("remote_as", remoteAs)
+	d.Set("keepalive", neighbor.KeepAlive)
+	d.Set("holddown", neighbor.HoldDown)
+	d.SetString("router_filtering_type", neighbor.RouteFilteringType)
+	d.Set("bfd_enabled", neighbor.BfdEnabled)
+	d.Set("bfd_interval", neighbor.BfdInterval)
+	d.Set("bfd_multiple", neighbor.BfdMultiple)
+	d.Set("allow_as_in", neighbor.AllowAsIn)
+	d.Set("hop_limit", neighbor.HopLimit)
+	d.SetString("restart_mode", neighbor.RestartMode)
+	if len(neighbor.Config.SourceAddresses) > 0 {
+		d.Set("config", []map[string]interface{}{{
+			"source_addresses": neighbor.Config.SourceAddresses,
+		}})
+	} else {
+		d.Set("config", []map[string]interface{}{})
+	}
+
+	return d.Error()
 }
 
 func (r *routerBgpNeighbor) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -4,44 +4,41 @@ package cmp
 
 import (
 	"context"
-	"fmt"
-	"log"
 
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
-	"github.com/tshihad/tftags"
 )
 
-type routerds struct {
-	nClient *client.RouterAPIService
-}
-
-func newRouterDS(nClient *client.RouterAPIService) *routerds {
-	return &routerds{nClient: nClient}
+func routerCatalogue(api *cmpAPI) catalogue[models.GetNetworkRouter] {
+	return catalogue[models.GetNetworkRouter]{
+		api:     api,
+		kind:    "router",
+		path:    func(d *utils.Data) string { return "networks/routers" },
+		listKey: "networkRouters",
+		itemKey: "networkRouter",
+		item: func(r models.GetNetworkRouter) (int, string, map[string]interface{}) {
+			return r.ID, r.Name, routerAttributes(r)
+		},
+		dateCreated: func(r models.GetNetworkRouter) string { return r.DateCreated },
+	}
 }
 
-func (n *routerds) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, n.nClient.Client)
-	log.Printf("[DEBUG] Get Router")
-	name := d.GetString("name")
-
-	// Pre check
-	if err := d.Error(); err != nil {
-		return err
+func newRouterDS(api *cmpAPI) *catalogueLookup[models.GetNetworkRouter] {
+	return &catalogueLookup[models.GetNetworkRouter]{
+		catalogue: routerCatalogue(api),
 	}
-	param := map[string]string{maxKey: "-1"}
-	routers, err := n.nClient.GetAllRouter(ctx, param)
-	if err != nil {
-		return err
-	}
-
-	for i, n := range routers.NetworkRouters {
-		if n.Name == name {
-			log.Print("[DEBUG]", routers.NetworkRouters[i].ID)
+}
 
-			return tftags.Set(d, routers.NetworkRouters[i])
-		}
+func newRouterList(api *cmpAPI) *catalogueList[models.GetNetworkRouter] {
+	return &catalogueList[models.GetNetworkRouter]{
+		catalogue: routerCatalogue(api),
+		attribute: "routers",
+		filter: func(ctx context.Context, d *utils.Data) (func(models.GetNetworkRouter) bool, error) {
+			cloudID := d.GetInt("cloud_id")
+
+			return func(r models.GetNetworkRouter) bool {
+				return cloudID == 0 || r.Zone.ID == cloudID
+			}, nil
+		},
 	}
-
-	return fmt.Errorf(errExactMatch, "Router")
 }
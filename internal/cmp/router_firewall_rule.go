@@ -0,0 +1,203 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
+)
+
+// routerFirewallRule manages the rules of router firewall rule groups. The
+// sdk does not support firewall rules, so the rules are managed using cmpAPI.
+type routerFirewallRule struct {
+	rClient *client.RouterAPIService
+	api     *cmpAPI
+}
+
+type firewallRuleBody struct {
+	Rule firewallRule `json:"rule"`
+}
+
+type firewallRule struct {
+	ID          int                `json:"id,omitempty"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Enabled     bool               `json:"enabled"`
+	Priority    int                `json:"priority,omitempty"`
+	Config      firewallRuleConfig `json:"config"`
+}
+
+type firewallRuleConfig struct {
+	Action               string                `json:"action"`
+	Direction            string                `json:"direction"`
+	Logged               bool                  `json:"logged"`
+	SourceGroups         []string              `json:"sourceGroups"`
+	SourceAddresses      []string              `json:"sourceAddresses"`
+	DestinationGroups    []string              `json:"destinationGroups"`
+	DestinationAddresses []string              `json:"destinationAddresses"`
+	Services             []string              `json:"services"`
+	ServiceEntries       []firewallRulePortSet `json:"serviceEntries"`
+	// Scope is the applied to groups of the distributed firewall rules
+	Scope []string `json:"scope,omitempty"`
+}
+
+// firewallRulePortSet is a L4 port set service entry of a rule
+type firewallRulePortSet struct {
+	Protocol         string   `json:"l4Protocol"`
+	DestinationPorts []string `json:"destinationPorts"`
+	SourcePorts      []string `json:"sourcePorts"`
+}
+
+type firewallRuleResp struct {
+	Success bool         `json:"success"`
+	ID      int          `json:"id"`
+	Rule    firewallRule `json:"rule"`
+}
+
+func newRouterFirewallRule(rClient *client.RouterAPIService, api *cmpAPI) *routerFirewallRule {
+	return &routerFirewallRule{
+		rClient: rClient,
+		api:     api,
+	}
+}
+
+func (r *routerFirewallRule) path(d *utils.Data) string {
+	return fmt.Sprintf("%s/%s", routerFirewallRuleGroupPath(d.GetInt("router_id"), d.GetInt("group_id")),
+		routerFirewallRulesPath)
+}
+
+func (r *routerFirewallRule) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.rClient.Client)
+	var resp firewallRuleResp
+	if err := r.api.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", r.path(d), d.GetID()), nil, nil, &resp); err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	setFirewallRule(d, resp.Rule)
+
+	return d.Error()
+}
+
+func (r *routerFirewallRule) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.rClient.Client)
+	var resp firewallRuleResp
+	if err := r.api.do(ctx, http.MethodPost, r.path(d), nil, firewallRuleBody{Rule: getFirewallRule(d)}, &resp); err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "creating firewall rule for the router")
+	}
+	id := resp.ID
+	if id == 0 {
+		id = resp.Rule.ID
+	}
+	d.SetID(id)
+	// server defaulted fields, such as priority, are set from the response
+	if resp.Rule.ID != 0 {
+		setFirewallRule(d, resp.Rule)
+	}
+
+	return d.Error()
+}
+
+func (r *routerFirewallRule) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.rClient.Client)
+	var resp firewallRuleResp
+	err := r.api.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d", r.path(d), d.GetID()), nil,
+		firewallRuleBody{Rule: getFirewallRule(d)}, &resp)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating firewall rule for the router")
+	}
+	// server defaulted fields, such as priority, are set from the response
+	if resp.Rule.ID != 0 {
+		setFirewallRule(d, resp.Rule)
+	}
+
+	return d.Error()
+}
+
+func (r *routerFirewallRule) Delete(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.rClient.Client)
+	var resp models.SuccessOrErrorMessage
+	if err := r.api.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", r.path(d), d.GetID()), nil, nil, &resp); err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("got success = 'false' while deleting firewall rule, error: %s", resp.Msg)
+	}
+
+	return nil
+}
+
+// getFirewallRule returns the firewall rule from the attributes of
+// schemas.FirewallRuleSchema
+func getFirewallRule(d *utils.Data) firewallRule {
+	var ports []firewallRulePortSet
+	for i := range d.GetListMap("ports") {
+		path := fmt.Sprintf("ports.%d.", i)
+		ports = append(ports, firewallRulePortSet{
+			Protocol:         d.GetString(path + "protocol"),
+			DestinationPorts: d.GetStringList(path + "destination_ports"),
+			SourcePorts:      d.GetStringList(path + "source_ports"),
+		})
+	}
+
+	return firewallRule{
+		Name:        d.GetString("name"),
+		Description: d.GetString("description"),
+		Enabled:     d.GetBool("enabled"),
+		Priority:    d.GetInt("priority"),
+		Config: firewallRuleConfig{
+			Action:               d.GetString("action"),
+			Direction:            d.GetString("direction"),
+			Logged:               d.GetBool("logging"),
+			SourceGroups:         d.GetStringList("source_groups"),
+			SourceAddresses:      d.GetStringList("source_addresses"),
+			DestinationGroups:    d.GetStringList("destination_groups"),
+			DestinationAddresses: d.GetStringList("destination_addresses"),
+			Services:             d.GetStringList("services"),
+			ServiceEntries:       ports,
+		},
+	}
+}
+
+// setFirewallRule sets the attributes of schemas.FirewallRuleSchema from the
+// rule returned by CMP
+func setFirewallRule(d *utils.Data, rule firewallRule) {
+	ports := make([]map[string]interface{}, 0, len(rule.Config.ServiceEntries))
+	for _, p := range rule.Config.ServiceEntries {
+		ports = append(ports, map[string]interface{}{
+			"protocol":          p.Protocol,
+			"destination_ports": p.DestinationPorts,
+			"source_ports":      p.SourcePorts,
+		})
+	}
+	d.SetString("name", rule.Name)
+	d.SetString("description", rule.Description)
+	d.Set("enabled", rule.Enabled)
+	d.Set("priority", rule.Priority)
+	d.SetString("action", rule.Config.Action)
+	d.SetString("direction", rule.Config.Direction)
+	d.Set("logging", rule.Config.Logged)
+	d.Set("source_groups", rule.Config.SourceGroups)
+	d.Set("source_addresses", rule.Config.SourceAddresses)
+	d.Set("destination_groups", rule.Config.DestinationGroups)
+	d.Set("destination_addresses", rule.Config.DestinationAddresses)
+	d.Set("services", rule.Config.Services)
+	d.Set("ports", ports)
+}
@@ -1,27 +1,38 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
 	"github.com/tshihad/tftags"
 )
 
 type routerFirewallRuleGroup struct {
 	rClient *client.RouterAPIService
+	// api is used to update the groups, which is not supported by the sdk
+	api *cmpAPI
 }
 
-func newRouterFirewallRuleGroup(rClient *client.RouterAPIService) *routerFirewallRuleGroup {
+func newRouterFirewallRuleGroup(rClient *client.RouterAPIService, api *cmpAPI) *routerFirewallRuleGroup {
 	return &routerFirewallRuleGroup{
 		rClient: rClient,
+		api:     api,
 	}
 }
 
+func routerFirewallRuleGroupPath(routerID, groupID int) string {
+	return fmt.Sprintf("%s/%s/%d/%s/%d", consts.NetworksPath, consts.NetworkRouterPath, routerID,
+		consts.RoutersFirewallRuleGroupPath, groupID)
+}
+
 func (r *routerFirewallRuleGroup) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
 	setMeta(meta, r.rClient.Client)
 	var tfModel models.CreateRouterFirewallRuleGroup
@@ -29,13 +40,27 @@ func (r *routerFirewallRuleGroup) Read(ctx context.Context, d *utils.Data, meta
 		return err
 	}
 
-	_, err := r.rClient.GetSpecificRouterFirewallRuleGroup(ctx, tfModel.RouterID,
+	resp, err := r.rClient.GetSpecificRouterFirewallRuleGroup(ctx, tfModel.RouterID,
 		tfModel.ID)
 	if err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
+
 		return err
 	}
 
-	return tftags.Set(d, tfModel)
+	// set the attributes from CMP, so that the changes outside terraform
+	// are detected
+	group := resp.GetSpecificRouterFirewallRuleGroup
+	d.SetString("name", group.Name)
+	d.SetString("description", group.Description)
+	d.Set("priority", group.Priority)
+	d.SetString("group_layer", group.GroupLayer)
+
+	return d.Error()
 }
 
 func (r *routerFirewallRuleGroup) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -62,8 +87,25 @@ func (r *routerFirewallRuleGroup) Create(ctx context.Context, d *utils.Data, met
 	return tftags.Set(d, tfModel)
 }
 
+// Update updates name, description and priority of the group
 func (r *routerFirewallRuleGroup) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
-	// to be implemented
+	setMeta(meta, r.rClient.Client)
+	var tfModel models.CreateRouterFirewallRuleGroup
+	if err := tftags.Get(d, &tfModel); err != nil {
+		return err
+	}
+	tfModel.ExternalType = routerFirewallExternalPolicy
+
+	var resp models.SuccessOrErrorMessage
+	err := r.api.do(ctx, http.MethodPut, routerFirewallRuleGroupPath(tfModel.RouterID, tfModel.ID), nil,
+		models.CreateRouterFirewallRuleGroupRequest{CreateRouterFirewallRuleGroup: tfModel}, &resp)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating firewall rule group for the router")
+	}
+
 	return nil
 }
 
@@ -1,24 +1,41 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
 	"fmt"
+	"log"
+	"net/http"
+	"strconv"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
 	"github.com/tshihad/tftags"
 )
 
 type routerNat struct {
 	rClient *client.RouterAPIService
+	// api is used to read the NAT rules, since the sdk response does not
+	// contain the NAT config
+	api *cmpAPI
 }
 
-func newRouterNat(routerNatClient *client.RouterAPIService) *routerNat {
+// routerNatResp is the NAT rule returned by CMP, including the NAT config
+type routerNatResp struct {
+	NAT struct {
+		models.GetSpecificRouterNat
+		Config models.CreateRouterNatConfig `json:"config"`
+	} `json:"networkRouterNAT"`
+}
+
+func newRouterNat(routerNatClient *client.RouterAPIService, api *cmpAPI) *routerNat {
 	return &routerNat{
 		rClient: routerNatClient,
+		api:     api,
 	}
 }
 
@@ -29,12 +46,48 @@ func (r *routerNat) Read(ctx context.Context, d *utils.Data, meta interface{}) e
 		return err
 	}
 
-	_, err := r.rClient.GetSpecificRouterNat(ctx, tfNat.RouterID, tfNat.ID)
-	if err != nil {
+	var resp routerNatResp
+	path := fmt.Sprintf("%s/%s/%d/%s/%d", consts.NetworksPath, consts.NetworkRouterPath, tfNat.RouterID,
+		consts.RoutersNatPath, tfNat.ID)
+	if err := r.api.do(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
+
 		return err
 	}
 
-	return tftags.Set(d, tfNat)
+	// set the attributes from CMP, so that the changes outside terraform
+	// are detected
+	nat := resp.NAT
+	d.SetString("name", nat.Name)
+	d.SetString("description", nat.Description)
+	d.Set("enabled", nat.Enabled)
+	d.SetString("source_network", nat.SourceNetwork)
+	d.SetString("destination_network", nat.DestinationNetwork)
+	d.SetString("translated_network", nat.TranslatedNetwork)
+	// translated ports are not set in CMP if empty. Port ranges are not
+	// supported by translated_ports, hence those are set as 0 as well, so
+	// that the change is shown in the plan.
+	translatedPorts := 0
+	if nat.TranslatedPorts != "" {
+		var err error
+		if translatedPorts, err = strconv.Atoi(nat.TranslatedPorts); err != nil {
+			log.Printf("[WARN] Unsupported translated ports %s of NAT rule %d", nat.TranslatedPorts, tfNat.ID)
+		}
+	}
+	d.Set("translated_ports", translatedPorts)
+	d.Set("priority", nat.Priority)
+	d.Set("config", []map[string]interface{}{{
+		"action":   nat.Config.Action,
+		"service":  nat.Config.Service,
+		"firewall": nat.Config.Firewall,
+		"logging":  nat.Config.Logging,
+	}})
+
+	return d.Error()
 }
 
 func (r *routerNat) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -1,27 +1,39 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
+	consts "github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/common"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	pkgUtils "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/utils"
 	"github.com/tshihad/tftags"
 )
 
+// routerRoute manages the static routes of the routers. The sdk does not
+// support updating the routes, so the updates are done using cmpAPI.
 type routerRoute struct {
 	rClient *client.RouterAPIService
+	api     *cmpAPI
 }
 
-func newRouterRoute(routeClient *client.RouterAPIService) *routerRoute {
+func newRouterRoute(routeClient *client.RouterAPIService, api *cmpAPI) *routerRoute {
 	return &routerRoute{
 		rClient: routeClient,
+		api:     api,
 	}
 }
 
+func routerRoutePath(routerID, routeID int) string {
+	return fmt.Sprintf("%s/%s/%d/%s/%d", consts.NetworksPath, consts.NetworkRouterPath, routerID,
+		consts.RouterRoutePath, routeID)
+}
+
 func (r *routerRoute) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
 	setMeta(meta, r.rClient.Client)
 	var tfRoute models.RouterRouteBody
@@ -30,10 +42,31 @@ func (r *routerRoute) Read(ctx context.Context, d *utils.Data, meta interface{})
 	}
 	resp, err := r.rClient.GetSpecificRouterRoute(ctx, tfRoute.RouterID, tfRoute.ID)
 	if err != nil {
+		if pkgUtils.GetStatusCode(err) == http.StatusNotFound {
+			d.SetID("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	// set the attributes from CMP, so that the changes outside terraform
+	// are detected
+	route := resp.NetworkRoute
+	d.SetString("name", route.Name)
+	d.SetString("description", route.Description)
+	d.Set("enabled", route.Enabled)
+	d.Set("default_route", route.DefaultRoute)
+	d.SetString("network", route.Source)
+	d.SetString("next_hop", route.Destination)
+	d.Set("mtu", route.NetworkMtu)
+	d.Set("priority", route.Priority)
+	if err := d.Error(); err != nil {
 		return err
 	}
 
-	return tftags.Set(d, resp.NetworkRoute)
+	return tftags.Set(d, route)
 }
 
 func (r *routerRoute) Create(ctx context.Context, d *utils.Data, meta interface{}) error {
@@ -60,6 +93,22 @@ func (r *routerRoute) Create(ctx context.Context, d *utils.Data, meta interface{
 }
 
 func (r *routerRoute) Update(ctx context.Context, d *utils.Data, meta interface{}) error {
+	setMeta(meta, r.rClient.Client)
+	var tfRoute models.RouterRouteBody
+	if err := tftags.Get(d, &tfRoute); err != nil {
+		return err
+	}
+
+	var resp models.SuccessOrErrorMessage
+	err := r.api.do(ctx, http.MethodPut, routerRoutePath(tfRoute.RouterID, tfRoute.ID), nil,
+		models.CreateRouterRoute{NetworkRoute: tfRoute}, &resp)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf(successErr, "updating route for the router")
+	}
+
 	return nil
 }
 
@@ -1,46 +1,63 @@
-// // (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
 	"context"
-	"fmt"
-	"log"
 
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 )
 
-type template struct {
-	tClient *client.VirtualImagesAPIService
+// templateCatalogue fetches virtual images directly from CMP API, since sdk
+// model of virtual image does not contain OS type details
+func templateCatalogue(api *cmpAPI) catalogue[cmpVirtualImage] {
+	return catalogue[cmpVirtualImage]{
+		api:       api,
+		kind:      "template",
+		path:      func(d *utils.Data) string { return "virtual-images" },
+		listKey:   "virtualImages",
+		itemKey:   "virtualImage",
+		nameQuery: true,
+		item: func(t cmpVirtualImage) (int, string, map[string]interface{}) {
+			return t.ID, t.Name, templateAttributes(t)
+		},
+		dateCreated: func(t cmpVirtualImage) string { return t.DateCreated },
+	}
 }
 
-func newTemplate(tClient *client.VirtualImagesAPIService) *template {
-	return &template{
-		tClient: tClient,
+func newTemplate(api *cmpAPI) *catalogueLookup[cmpVirtualImage] {
+	return &catalogueLookup[cmpVirtualImage]{
+		catalogue: templateCatalogue(api),
+		params: func(d *utils.Data) map[string]string {
+			return map[string]string{filterTypeKey: syncedTypeValue}
+		},
+		filter: templateFilter,
 	}
 }
 
-func (t *template) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	setMeta(meta, t.tClient.Client)
-	log.Printf("[DEBUG] Get Templates")
-
-	name := d.GetString("name")
-	if err := d.Error(); err != nil {
-		return err
-	}
-	template, err := t.tClient.GetAllVirtualImages(ctx, map[string]string{
-		nameKey:       name,
-		filterTypeKey: syncedTypeValue,
-	})
-	if err != nil {
-		return err
-	}
-	if len(template.VirtualImages) != 1 {
-		return fmt.Errorf(errExactMatch, "templates")
+func newTemplateList(api *cmpAPI) *catalogueList[cmpVirtualImage] {
+	return &catalogueList[cmpVirtualImage]{
+		catalogue: templateCatalogue(api),
+		attribute: "templates",
+		params: func(d *utils.Data) map[string]string {
+			return map[string]string{filterTypeKey: syncedTypeValue}
+		},
+		filter: templateFilter,
 	}
-	d.SetID(template.VirtualImages[0].ID)
+}
+
+// templateFilter matches the templates with the OS type, image type and all
+// the tags
+func templateFilter(_ context.Context, d *utils.Data) (func(cmpVirtualImage) bool, error) {
+	osType := d.GetString("os_type")
+	imageType := d.GetString("image_type")
+	tags := d.GetMap("tags")
+
+	return func(t cmpVirtualImage) bool {
+		if (osType != "" && t.OsType.Code != osType) || (imageType != "" && t.ImageType != imageType) {
+			return false
+		}
 
-	// post check
-	return d.Error()
+		return hasTags(t.Tags, tags)
+	}, d.Error()
 }
@@ -1,61 +1,34 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package cmp
 
 import (
-	"context"
-	"fmt"
-
-	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/client"
 	"github.com/HewlettPackard/hpegl-vmaas-cmp-go-sdk/pkg/models"
-	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
-	"github.com/tshihad/tftags"
 )
 
-type transportZone struct {
-	tClient *client.RouterAPIService
-}
-
-func newTransportZone(tClient *client.RouterAPIService) *transportZone {
-	return &transportZone{
-		tClient: tClient,
-	}
-}
-
-func (r *transportZone) Read(ctx context.Context, d *utils.Data, meta interface{}) error {
-	nsxType, err := GetNsxTypeFromCMP(ctx, r.tClient.Client)
-	if err != nil {
-		return err
-	}
-	setMeta(meta, r.tClient.Client)
-	var tfScope models.NetworkScope
-	if err := tftags.Get(d, &tfScope); err != nil {
-		return err
-	}
-
-	// Get network server ID for nsx-t
-	serverResp, err := r.tClient.GetNetworkServices(ctx, nil)
-	if err != nil {
-		return err
+// newTransportZone looks up the transport zones, i.e. network scopes, of the
+// NSX network server. CMP does not support getting a single transport zone.
+func newTransportZone(api *cmpAPI) *catalogueLookup[models.NetworkScope] {
+	return &catalogueLookup[models.NetworkScope]{
+		catalogue: catalogue[models.NetworkScope]{
+			api:         api,
+			kind:        "transport zone",
+			pathContext: nsxServerPath(api, "scopes"),
+			listKey:     "networkScopes",
+			item: func(s models.NetworkScope) (int, string, map[string]interface{}) {
+				return s.ID, s.Name, map[string]interface{}{
+					"display_name": s.DisplayName,
+					"internal_id":  s.InternalID,
+					"external_id":  s.ExternalID,
+					"provider_id":  s.ProviderID,
+					"status":       s.Status,
+					"enabled":      s.Enabled,
+					"active":       s.Active,
+					"visibility":   s.Visibility,
+					"cloud_id":     s.Zone.ID,
+				}
+			},
+			dateCreated: func(s models.NetworkScope) string { return s.DateCreated },
+		},
 	}
-
-	var serverID int
-	for i, n := range serverResp.NetworkServices {
-		if n.TypeName == nsxType {
-			serverID = serverResp.NetworkServices[i].ID
-
-			break
-		}
-	}
-
-	if serverID == 0 {
-		return fmt.Errorf(errExactMatch, "network server")
-	}
-
-	resp, err := r.tClient.GetTransportZones(ctx, serverID, tfScope.Name)
-	if err != nil {
-		return err
-	}
-
-	return tftags.Set(d, resp)
 }
@@ -36,9 +36,21 @@ const (
 
 	DSMorpheusDataSource = "hpegl_vmaas_morpheus_details"
 
+	DSNetworks       = "hpegl_vmaas_networks"
+	DSPlans          = "hpegl_vmaas_plans"
+	DSTemplates      = "hpegl_vmaas_templates"
+	DSDatastores     = "hpegl_vmaas_datastores"
+	DSClouds         = "hpegl_vmaas_clouds"
+	DSGroups         = "hpegl_vmaas_groups"
+	DSPowerSchedules = "hpegl_vmaas_power_schedules"
+	DSRouters        = "hpegl_vmaas_routers"
+	DSLoadBalancers  = "hpegl_vmaas_load_balancers"
+
 	// resource key
 	ResInstance                   = "hpegl_vmaas_instance"
 	ResInstanceClone              = "hpegl_vmaas_instance_clone"
+	ResInstanceAction             = "hpegl_vmaas_instance_action"
+	ResInstanceGroup              = "hpegl_vmaas_instance_group"
 	ResNetwork                    = "hpegl_vmaas_network"
 	ResRouter                     = "hpegl_vmaas_router"
 	ResLoadBalancer               = "hpegl_vmaas_load_balancer"
@@ -48,9 +60,17 @@ const (
 	ResLoadBalancerVirtualServers = "hpegl_vmaas_load_balancer_virtual_server"
 	ResRouterNat                  = "hpegl_vmaas_router_nat_rule"
 	ResRouterFirewallRuleGroup    = "hpegl_vmaas_router_firewall_rule_group"
+	ResRouterFirewallRule         = "hpegl_vmaas_router_firewall_rule"
+	ResDfwPolicy                  = "hpegl_vmaas_distributed_firewall_policy"
+	ResDfwRule                    = "hpegl_vmaas_distributed_firewall_rule"
+	ResNsxSecurityGroup           = "hpegl_vmaas_nsx_security_group"
 	ResRouterRoute                = "hpegl_vmaas_router_route"
 	ResRouterBgpNeighbor          = "hpegl_vmaas_router_bgp_neighbor"
 	ResDhcpServer                 = "hpegl_vmaas_dhcp_server"
+	ResNetworkPool                = "hpegl_vmaas_network_pool"
+	ResIPAddress                  = "hpegl_vmaas_ip_address"
+	ResNetworkDomain              = "hpegl_vmaas_network_domain"
+	ResDNSRecord                  = "hpegl_vmaas_dns_record"
 
 	// documentation related constants
 	generalNamedesc = "Name of the %s as it appears on HPE GreenLake for private cloud dashboard. " +
@@ -1,10 +1,11 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,23 +14,13 @@ import (
 
 func CloudFolderData() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: f(generalNamedesc, "cloud folder", "cloud folder"),
-			},
+		Schema: schemas.LookupSchema("cloud folder", map[string]*schema.Schema{
 			"cloud_id": {
 				Type:        schema.TypeString,
 				Required:    true,
 				Description: f(generalDDesc, "cloud"),
 			},
-			"code": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "External ID or code for the folder.",
-			},
-		},
+		}, schemas.CloudFolderAttributesSchema()),
 		ReadContext: cloudFolderReadContext,
 		Description: `The ` + DSCloudFolder + ` data source can be used to discover the ID for a folder.
 		` + DSCloudFolder + ` can be used along with hpegl_vmaas_instance, If it is used, all instances/VMs
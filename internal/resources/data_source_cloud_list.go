@@ -0,0 +1,44 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func CloudListData() *schema.Resource {
+	return &schema.Resource{
+		Schema: schemas.CatalogueListSchema("clouds", "cloud", map[string]*schema.Schema{
+			"type_code": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Code of the cloud type. Only the clouds of the type will be listed.",
+			},
+		}, schemas.CloudAttributesSchema()),
+		ReadContext: cloudListReadContext,
+		Description: `The ` + DSClouds + ` data source can be used to list the hpegl vmaas clouds,
+		optionally only those of the given cloud type, along with
+		their attributes. All the pages are fetched from CMP.`,
+	}
+}
+
+func cloudListReadContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(d)
+	err = c.CmpClient.CloudList.Read(ctx, data, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
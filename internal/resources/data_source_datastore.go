@@ -1,10 +1,11 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,22 +14,27 @@ import (
 
 func DatastoreData() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: f(generalNamedesc, "datastore", "datastore"),
-			},
+		Schema: schemas.LookupSchema("datastore", schemas.WithAttributes(map[string]*schema.Schema{
 			"cloud_id": {
 				Type:        schema.TypeString,
 				Required:    true,
 				Description: f(generalDDesc, "cloud"),
 			},
-		},
+			"most_free_space": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If more than one datastore matches the arguments, use the one with " +
+					"the most free space.",
+			},
+		}, schemas.DatastoreFilterSchema(true)), schemas.DatastoreAttributesSchema(),
+			"name_prefix", "type", "tags", "min_free_gb", "most_free_space"),
 		ReadContext: datastoreReadContext,
 		Description: `The ` + DSDatastore + ` data source can be used to discover the ID of a hpegl vmaas datastore.
 		This can then be used with resources or data sources that require a ` + DSDatastore + `,
-		such as the ` + ResInstance + ` resource.`,
+		such as the ` + ResInstance + ` resource. Instead of a name, most_free_space can be used
+		to select the datastore with the most free space, so the volumes are balanced across
+		the datastores.`,
 		SchemaVersion:  0,
 		StateUpgraders: nil,
 		Importer: &schema.ResourceImporter{
@@ -0,0 +1,44 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DatastoreListData() *schema.Resource {
+	return &schema.Resource{
+		Schema: schemas.CatalogueListSchema("datastores", "datastore", schemas.WithAttributes(map[string]*schema.Schema{
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: f(generalDDesc, "cloud"),
+			},
+		}, schemas.DatastoreFilterSchema(false)), schemas.DatastoreAttributesSchema()),
+		ReadContext: datastoreListReadContext,
+		Description: `The ` + DSDatastores + ` data source can be used to list the hpegl vmaas datastores
+		of the given cloud, optionally filtered by type, tags or minimum free space, along with
+		their attributes. All the pages are fetched from CMP.`,
+	}
+}
+
+func datastoreListReadContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(d)
+	err = c.CmpClient.DatastoreList.Read(ctx, data, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
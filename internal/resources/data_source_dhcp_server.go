@@ -1,10 +1,11 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,23 +14,7 @@ import (
 
 func DhcpServerData() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: f(generalNamedesc, "DHCP Server", "DHCP Server"),
-			},
-			"id": {
-				Type:        schema.TypeInt,
-				Computed:    true,
-				Description: "id can be obtained by using DHCP Server datasource/resource.",
-			},
-			"provider_id": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "ProviderId of the DHCP Server. Use the provider_id  while creating DHCP NSX-T Segment Network",
-			},
-		},
+		Schema:      schemas.LookupSchema("DHCP server", nil, schemas.DHCPServerAttributesSchema()),
 		ReadContext: DHCPServerReadContext,
 		Description: `The ` + DSDhcpServer + ` data source can be used to discover the ID of a hpegl vmaas DHCP server.
 		This can then be used with resources or data sources that require a ` + DSDhcpServer + `,
@@ -1,10 +1,11 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,18 +14,7 @@ import (
 
 func DomainData() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: f(generalNamedesc, "network domain", "network domain"),
-			},
-			"active": {
-				Type:        schema.TypeBool,
-				Computed:    true,
-				Description: "Flag denotes active domain or not",
-			},
-		},
+		Schema:      schemas.LookupSchema("network domain", nil, schemas.DomainAttributesSchema()),
 		ReadContext: domainReadContext,
 		Description: `The ` + DSNetworkDomain + ` data source can be used to discover the ID of an ` + DSNetworkDomain + `.
 		 This can then be used with resources or data sources that require an ` + DSNetworkDomain + `
@@ -1,10 +1,11 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,26 +14,7 @@ import (
 
 func EdgeClusterData() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: f(generalNamedesc, "Edge Cluster", "Edge Cluster"),
-			},
-			"internal_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"external_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"provider_id": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "ProviderId of the Edge Cluster. Use the provider_id as EdgeCluster while creating NSX-T Router",
-			},
-		},
+		Schema:      schemas.LookupSchema("edge cluster", nil, schemas.EdgeClusterAttributesSchema()),
 		ReadContext: edgeClusterReadContext,
 		Description: `The ` + DSEdgeCluster + ` data source can be used to discover the Provider ID of a hpegl vmaas Edge cluster.
 		This can then be used with resources or data sources that require a ` + DSEdgeCluster,
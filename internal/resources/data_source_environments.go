@@ -1,10 +1,11 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,18 +14,7 @@ import (
 
 func EnvironmentData() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: f(generalNamedesc, "Environment", "Environment"),
-			},
-			"code": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "code of each environment",
-			},
-		},
+		Schema:      schemas.LookupSchema("environment", nil, schemas.EnvironmentAttributesSchema()),
 		ReadContext: environmentReadContext,
 		Description: `The hpegl_vmaas_environment data source can be used to discover the ID/Code of a hpegl vmaas environment.
 		This can then be used with resources or data sources that require a hpegl_vmaas_environment,
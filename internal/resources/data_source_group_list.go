@@ -0,0 +1,37 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func GroupListData() *schema.Resource {
+	return &schema.Resource{
+		Schema:      schemas.CatalogueListSchema("groups", "group", nil, schemas.GroupAttributesSchema()),
+		ReadContext: groupListReadContext,
+		Description: `The ` + DSGroups + ` data source can be used to list all the hpegl vmaas groups
+		along with their attributes. All the pages are fetched from CMP.`,
+	}
+}
+
+func groupListReadContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(d)
+	err = c.CmpClient.GroupList.Read(ctx, data, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
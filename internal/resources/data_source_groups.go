@@ -5,6 +5,7 @@ package resources
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,13 +14,7 @@ import (
 
 func GroupData() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: f(generalNamedesc, "group", "group"),
-			},
-		},
+		Schema:      schemas.LookupSchema("group", nil, schemas.GroupAttributesSchema()),
 		ReadContext: groupReadContext,
 		Description: `The ` + DSGroup + ` data source can be used to discover the ID of a hpegl vmaas group.
 		This can then be used with resources or data sources that require a ` + DSGroup + `,
@@ -1,10 +1,11 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,19 +14,14 @@ import (
 
 func LayoutData() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: f(generalNamedesc, "layout", "layout"),
-			},
+		Schema: schemas.LookupSchema("layout", map[string]*schema.Schema{
 			"instance_type_code": {
 				Type:     schema.TypeString,
 				Required: true,
 				Description: `Unique code used to identify the instance type. instance_type_code
 					can be used in resource hpegl_vmaas_instance`,
 			},
-		},
+		}, schemas.LayoutAttributesSchema()),
 		ReadContext: layoutReadContext,
 		Description: `The ` + DSLayout + ` data source can be used to discover the ID of a hpegl vmaas layout.
 		This can then be used with resources or data sources that require a ` + DSLayout + `,
@@ -1,10 +1,11 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,18 +14,13 @@ import (
 
 func LoadBalancerData() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: f(generalNamedesc, "LoadBalancer", "LoadBalancer"),
-			},
+		Schema: schemas.LookupSchema("load balancer", map[string]*schema.Schema{
 			"lb_id": {
 				Type:        schema.TypeInt,
 				Computed:    true,
 				Description: "Parent lb ID, lb_id can be obtained by using LB datasource/resource.",
 			},
-		},
+		}, schemas.LoadBalancerAttributesSchema()),
 		ReadContext: LoadBalancerReadContext,
 		Description: `The ` + DSLoadBalancer + ` data source can be used to discover the ID of a hpegl vmaas network load balancer.
 		This can then be used with resources or data sources that require a ` + DSLoadBalancer + `,
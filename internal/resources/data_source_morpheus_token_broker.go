@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 )
@@ -21,23 +22,58 @@ func MorpheusDetailsBroker() *schema.Resource {
 				Description: "Morpheus access_token",
 				Sensitive:   true,
 			},
+			"refresh_before": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validations.ValidateDuration,
+				Description: `Duration such as 5m or 1h. If the access_token expires within this duration,
+				a new access_token is fetched. Broker may return the same access_token until it is
+				close to the expiry, in which case the access_token is used with a warning.`,
+			},
 			"valid_till": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "time until the token expires, in seconds",
+				Description: "time until the token expires, in seconds, as of the time of reading",
+				Deprecated:  "valid_till is relative to the time of reading, use expires_at instead",
 				Sensitive:   false,
 			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "time when the access_token expires, in RFC3339 format",
+			},
 			"url": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "Morpheus URL",
 				Sensitive:   false,
 			},
+			"cmp_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the Morpheus instance, e.g. 6.2.4",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Location of the Morpheus instance, as configured in the provider",
+			},
+			"space_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Space name, as configured in the provider",
+			},
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Tenant ID used to get the Morpheus details",
+			},
 		},
 		ReadContext: MorpheusDetailsBrokerReadContext,
 		Description: `The ` + DSMorpheusDataSource + ` data source can be used to get a details of the Morpheus instance
-		used by VMaaS.  The details that can be retrieved are the access_token, valid_till (time until the token expires,
-		in seconds), and the URL of the Morpheus instance.`,
+		used by VMaaS.  The details that can be retrieved are the access_token, expires_at (time when the token expires,
+		in RFC3339 format), the URL and version of the Morpheus instance, and the location, space and tenant of the
+		provider.`,
 		SchemaVersion:  0,
 		StateUpgraders: nil,
 		Importer: &schema.ResourceImporter{
@@ -0,0 +1,49 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func NetworkListData() *schema.Resource {
+	return &schema.Resource{
+		Schema: schemas.CatalogueListSchema("networks", "network", map[string]*schema.Schema{
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the cloud. Only the networks in the cloud will be listed.",
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the group. Only the networks in the clouds of the group will be listed.",
+			},
+		}, schemas.NetworkAttributesSchema()),
+		ReadContext: networkListReadContext,
+		Description: `The ` + DSNetworks + ` data source can be used to list the hpegl vmaas networks,
+		optionally only those in the given cloud or group, along with
+		their attributes. All the pages are fetched from CMP.`,
+	}
+}
+
+func networkListReadContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(d)
+	err = c.CmpClient.NetworkList.Read(ctx, data, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
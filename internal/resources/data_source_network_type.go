@@ -5,6 +5,7 @@ package resources
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,13 +14,7 @@ import (
 
 func NetworkTypeData() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Name of the network type. Example 'NSX Segment' or 'Custom Network'",
-			},
-		},
+		Schema:         schemas.LookupSchema("network type", nil, schemas.NetworkTypeAttributesSchema()),
 		ReadContext:    networkTypeReadContext,
 		SchemaVersion:  0,
 		StateUpgraders: nil,
@@ -5,6 +5,7 @@ package resources
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,13 +14,7 @@ import (
 
 func NetworkData() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: f(generalNamedesc, "network", "network"),
-			},
-		},
+		Schema:      schemas.LookupSchema("network", nil, schemas.NetworkAttributesSchema()),
 		ReadContext: networkReadContext,
 		Description: `The ` + DSNetwork + ` data source can be used to discover the ID of a hpegl vmaas network.
 		This can then be used with resources or data sources that require a ` + DSNetwork + `,
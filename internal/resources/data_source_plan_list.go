@@ -0,0 +1,44 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func PlanListData() *schema.Resource {
+	return &schema.Resource{
+		Schema: schemas.CatalogueListSchema("plans", "plan", schemas.WithAttributes(map[string]*schema.Schema{
+			"provision_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Provision type code of the plans. Plans of all the provision types are listed if not set.",
+			},
+		}, schemas.PlanSizingSchema()), schemas.PlanAttributesSchema()),
+		ReadContext: planListReadContext,
+		Description: `The ` + DSPlans + ` data source can be used to list the hpegl vmaas plans,
+		optionally only those of the given provision type or satisfying the sizing
+		requirements, along with their attributes. All the pages are fetched from CMP.`,
+	}
+}
+
+func planListReadContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(d)
+	err = c.CmpClient.PlanList.Read(ctx, data, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
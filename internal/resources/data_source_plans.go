@@ -1,10 +1,11 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,17 +14,20 @@ import (
 
 func PlanData() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: f(generalNamedesc, "Plan", "Plan"),
+		Schema: schemas.LookupSchema("plan", schemas.WithAttributes(map[string]*schema.Schema{
+			"provision_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: "Provision type code of the plan, such as 'vmware'. Defaults to 'vmware', unless " +
+					"provision_type filter is set.",
 			},
-		},
+		}, schemas.PlanSizingSchema()), schemas.PlanAttributesSchema(), "min_cores", "min_memory_gb", "min_storage_gb"),
 		ReadContext: planReadContext,
 		Description: `The ` + DSPlan + ` data source can be used to discover the ID of a hpegl vmaas plan.
 		This can then be used with resources or data sources that require a ` + DSPlan + `,
-		such as the ` + ResInstance + ` resource.`,
+		such as the ` + ResInstance + ` resource. If min_cores, min_memory_gb or min_storage_gb
+		are set, the smallest plan satisfying all of them is selected.`,
 		SchemaVersion:  0,
 		StateUpgraders: nil,
 		Importer: &schema.ResourceImporter{
@@ -5,6 +5,7 @@ package resources
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,13 +14,7 @@ import (
 
 func PowerScheduleData() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: f(generalNamedesc, "PowerSchedule", "PowerSchedule"),
-			},
-		},
+		Schema:      schemas.LookupSchema("power schedule", nil, schemas.PowerScheduleAttributesSchema()),
 		ReadContext: powerScheduleReadContext,
 		Description: `The ` + DSPowerSchedule + ` data source can be used to discover the ID of a hpegl vmaas powerSchedule.
 		This can then be used with resources or data sources that require a ` + DSPowerSchedule + `,
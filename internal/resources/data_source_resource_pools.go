@@ -1,10 +1,11 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,18 +14,13 @@ import (
 
 func ResourcePoolData() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: f(generalNamedesc, "Resource pool of a cluster", "Resource pool"),
-			},
+		Schema: schemas.LookupSchema("resource pool", map[string]*schema.Schema{
 			"cloud_id": {
 				Type:        schema.TypeString,
 				Required:    true,
 				Description: f(generalDDesc, "cloud"),
 			},
-		},
+		}, schemas.ResourcePoolAttributesSchema()),
 		ReadContext: resourcePoolReadContext,
 		Description: `The ` + DSResourcePool + ` data source can be used to discover the ID of an hpegl vmaas resource pool.
 		This can then be used with resources or data sources that require an ` + DSResourcePool + `,
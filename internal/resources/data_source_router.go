@@ -1,10 +1,11 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,43 +14,7 @@ import (
 
 func RouterData() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: f(generalNamedesc, "Router", "Router"),
-			},
-			"provider_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-				Description: "Provider ID of the given router/gateway. This field can be used as connected_gateway in " +
-					ResNetwork,
-			},
-			"interfaces": {
-				Type:        schema.TypeList,
-				Computed:    true,
-				Description: "Interface Configuration",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": {
-							Type:        schema.TypeInt,
-							Computed:    true,
-							Description: "ID of the Uplink Interface",
-						},
-						"source_addresses": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "Interface IP Address of the Uplink Interface",
-						},
-						"cidr": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "CIDR of the network the Uplink Interface",
-						},
-					},
-				},
-			},
-		},
+		Schema:      schemas.LookupSchema("router", nil, schemas.RouterAttributesSchema()),
 		ReadContext: RouterReadContext,
 		Description: `The ` + DSRouter + ` data source can be used to discover the ID of a hpegl vmaas router.
 		This can then be used with resources or data sources that require a ` + DSRouter + `,
@@ -0,0 +1,44 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func RouterListData() *schema.Resource {
+	return &schema.Resource{
+		Schema: schemas.CatalogueListSchema("routers", "router", map[string]*schema.Schema{
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the cloud. Only the routers in the cloud will be listed.",
+			},
+		}, schemas.RouterAttributesSchema()),
+		ReadContext: routerListReadContext,
+		Description: `The ` + DSRouters + ` data source can be used to list the hpegl vmaas routers,
+		optionally only those in the given cloud, along with
+		their attributes. All the pages are fetched from CMP.`,
+	}
+}
+
+func routerListReadContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(d)
+	err = c.CmpClient.RouterList.Read(ctx, data, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
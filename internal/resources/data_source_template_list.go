@@ -0,0 +1,39 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TemplateListData() *schema.Resource {
+	return &schema.Resource{
+		Schema: schemas.CatalogueListSchema("templates", "template", schemas.TemplateFilterSchema(false),
+			schemas.TemplateAttributesSchema()),
+		ReadContext: templateListReadContext,
+		Description: `The ` + DSTemplates + ` data source can be used to list the hpegl vmaas templates,
+		optionally only those with the given name prefix, OS type, image type or tags, along with
+		their attributes. All the pages are fetched from CMP.`,
+	}
+}
+
+func templateListReadContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(d)
+	err = c.CmpClient.TemplateList.Read(ctx, data, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
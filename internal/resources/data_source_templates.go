@@ -1,10 +1,11 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,17 +14,13 @@ import (
 
 func TemplateData() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: f(generalNamedesc, "Template", "Template"),
-			},
-		},
+		Schema: schemas.LookupSchema("template", schemas.TemplateFilterSchema(true), schemas.TemplateAttributesSchema(),
+			"name_prefix", "os_type", "image_type", "tags"),
 		ReadContext: templateReadContext,
 		Description: `The ` + DSTemplate + ` data source can be used to discover the ID of a hpegl vmaas template.
 		This can then be used with resources or data sources that require a ` + DSTemplate + `,
-		such as the ` + ResInstance + ` resource.`,
+		such as the ` + ResInstance + ` resource. Versioned templates can be selected using
+		name_prefix or name_regex along with most_recent, which selects the most recently created one.`,
 		SchemaVersion:  0,
 		StateUpgraders: nil,
 		Importer: &schema.ResourceImporter{
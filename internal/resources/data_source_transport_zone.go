@@ -1,10 +1,11 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,26 +14,7 @@ import (
 
 func TransportZoneData() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: f(generalNamedesc, "transport zone", "transport zone"),
-			},
-			"internal_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"external_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"provider_id": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "scope_id of the transport zone. Use the scope_id as transport zone while creating network",
-			},
-		},
+		Schema:      schemas.LookupSchema("transport zone", nil, schemas.TransportZoneAttributesSchema()),
 		ReadContext: transportZoneReadContext,
 		Description: `The ` + DSTransportZone + ` data source can be used to discover the ID of a hpegl vmaas transport zone.
 		This can then be used with resources or data sources that require an ` + DSTransportZone,
@@ -6,4 +6,7 @@ const (
 	// Router Constants
 	DefaultRestartTimer = 180
 	DefaultStaleTimer   = 600
+	// IP families
+	ipv4 = "IPv4"
+	ipv6 = "IPv6"
 )
@@ -0,0 +1,38 @@
+//  (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package diffvalidation
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type DNSRecord struct {
+	diff *schema.ResourceDiff
+}
+
+func NewDNSRecordValidate(diff *schema.ResourceDiff) *DNSRecord {
+	return &DNSRecord{
+		diff: diff,
+	}
+}
+
+func (r *DNSRecord) DiffValidate() error {
+	return r.validateContent()
+}
+
+// validateContent validates the content of A and AAAA records is an IP
+// address of the record type. Content of CNAME and PTR records is a name.
+func (r *DNSRecord) validateContent() error {
+	recordType, _ := r.diff.Get("type").(string)
+	family := map[string]string{"A": ipv4, "AAAA": ipv6}[recordType]
+	if family == "" || !r.diff.NewValueKnown("content") {
+		return nil
+	}
+	if f, ok := getIPFamily(r.diff, "content"); !ok || f != family {
+		return fmt.Errorf("content: content should be an %s address for %s record", family, recordType)
+	}
+
+	return nil
+}
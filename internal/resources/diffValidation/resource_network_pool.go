@@ -0,0 +1,84 @@
+//  (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package diffvalidation
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type NetworkPool struct {
+	diff *schema.ResourceDiff
+}
+
+func NewNetworkPoolValidate(diff *schema.ResourceDiff) *NetworkPool {
+	return &NetworkPool{
+		diff: diff,
+	}
+}
+
+func (p *NetworkPool) DiffValidate() error {
+	return p.validateIPRanges()
+}
+
+type poolRange struct {
+	path       string
+	start, end netip.Addr
+}
+
+// validateIPRanges validates the ranges of the pool are valid and do not
+// overlap each other or the gateway. Ranges which are unknown at plan time
+// are skipped.
+func (p *NetworkPool) validateIPRanges() error {
+	var errs []error
+	count, _ := p.diff.Get("ip_ranges.#").(int)
+	ranges := make([]poolRange, 0, count)
+	for i := 0; i < count; i++ {
+		path := fmt.Sprintf("ip_ranges.%d", i)
+		if !p.diff.NewValueKnown(path+".start_address") || !p.diff.NewValueKnown(path+".end_address") {
+			continue
+		}
+		startStr, _ := p.diff.Get(path + ".start_address").(string)
+		endStr, _ := p.diff.Get(path + ".end_address").(string)
+		start, err1 := netip.ParseAddr(startStr)
+		end, err2 := netip.ParseAddr(endStr)
+		switch {
+		case err1 != nil || err2 != nil:
+			// invalid addresses are reported by the schema validation
+			continue
+		case start.BitLen() != end.BitLen():
+			errs = append(errs, fmt.Errorf("%s: start and end addresses are of different IP versions", path))
+		case end.Less(start):
+			errs = append(errs, fmt.Errorf("%s: start address %s is after the end address %s", path, start, end))
+		default:
+			ranges = append(ranges, poolRange{path: path, start: start, end: end})
+		}
+	}
+
+	for i := range ranges {
+		for j := i + 1; j < len(ranges); j++ {
+			if inRange(ranges[j].start, ranges[i].start, ranges[i].end) ||
+				inRange(ranges[i].start, ranges[j].start, ranges[j].end) {
+				errs = append(errs, fmt.Errorf("%s: range %s-%s overlaps %s range %s-%s", ranges[j].path,
+					ranges[j].start, ranges[j].end, ranges[i].path, ranges[i].start, ranges[i].end))
+			}
+		}
+	}
+
+	if p.diff.NewValueKnown("gateway") {
+		v, _ := p.diff.Get("gateway").(string)
+		if gateway, err := netip.ParseAddr(v); err == nil {
+			for _, r := range ranges {
+				if inRange(gateway, r.start, r.end) {
+					errs = append(errs, fmt.Errorf("gateway: gateway %s is within %s range %s-%s",
+						gateway, r.path, r.start, r.end))
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
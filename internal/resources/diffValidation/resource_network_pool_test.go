@@ -0,0 +1,107 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package diffvalidation
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testNetworkPoolSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"gateway": testStringSchema(),
+		"ip_ranges": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"start_address": testStringSchema(),
+					"end_address":   testStringSchema(),
+				},
+			},
+		},
+	}
+}
+
+func testIPRanges(ranges ...string) []interface{} {
+	ipRanges := make([]interface{}, 0, len(ranges)/2)
+	for i := 0; i+1 < len(ranges); i += 2 {
+		ipRanges = append(ipRanges, map[string]interface{}{
+			"start_address": ranges[i],
+			"end_address":   ranges[i+1],
+		})
+	}
+
+	return ipRanges
+}
+
+func TestNetworkPoolDiffValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "Test case 1: valid ranges",
+			config: map[string]interface{}{
+				"gateway":   "10.0.0.1",
+				"ip_ranges": testIPRanges("10.0.0.10", "10.0.0.20", "10.0.0.21", "10.0.0.30"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test case 2: overlapping ranges",
+			config: map[string]interface{}{
+				"ip_ranges": testIPRanges("10.0.0.10", "10.0.0.20", "10.0.0.20", "10.0.0.30"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test case 3: range containing another range",
+			config: map[string]interface{}{
+				"ip_ranges": testIPRanges("10.0.0.15", "10.0.0.16", "10.0.0.10", "10.0.0.30"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test case 4: start after end",
+			config: map[string]interface{}{
+				"ip_ranges": testIPRanges("10.0.0.20", "10.0.0.10"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test case 5: mixed IP versions",
+			config: map[string]interface{}{
+				"ip_ranges": testIPRanges("10.0.0.10", "2001:db8::10"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test case 6: gateway within range",
+			config: map[string]interface{}{
+				"gateway":   "10.0.0.15",
+				"ip_ranges": testIPRanges("10.0.0.10", "10.0.0.20"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test case 7: invalid address is left to schema validation",
+			config: map[string]interface{}{
+				"ip_ranges": testIPRanges("10.0.0", "10.0.0.20"),
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testDiffValidate(testNetworkPoolSchema(), tt.config, func(diff *schema.ResourceDiff) error {
+				return NewNetworkPoolValidate(diff).DiffValidate()
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DiffValidate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
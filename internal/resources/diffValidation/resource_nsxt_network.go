@@ -3,7 +3,10 @@
 package diffvalidation
 
 import (
+	"errors"
 	"fmt"
+	"net/netip"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,8 +16,34 @@ const (
 	isDhcpEnabled = "dhcp_enabled"
 )
 
+// ipConfigPaths are the attributes of the IP configuration of an IP family
+type ipConfigPaths struct {
+	cidr, gateway, primaryDNS, secondaryDNS, dhcpRange, dhcpServerAddress string
+}
+
+var (
+	ipv4ConfigPaths = ipConfigPaths{
+		cidr:              "cidr",
+		gateway:           "gateway",
+		primaryDNS:        "primary_dns",
+		secondaryDNS:      "secondary_dns",
+		dhcpRange:         "dhcp_network.0.dhcp_range",
+		dhcpServerAddress: "dhcp_network.0.dhcp_server_address",
+	}
+	// IPv6 configuration of dual stack networks
+	ipv6ConfigPaths = ipConfigPaths{
+		cidr:              "cidr_ipv6",
+		gateway:           "gateway_ipv6",
+		primaryDNS:        "primary_dns_ipv6",
+		secondaryDNS:      "secondary_dns_ipv6",
+		dhcpRange:         "dhcp_network.0.dhcp_range_ipv6",
+		dhcpServerAddress: "dhcp_network.0.dhcp_server_address_ipv6",
+	}
+)
+
 type Network struct {
 	diff *schema.ResourceDiff
+	errs []error
 }
 
 func NewNetworkValidate(diff *schema.ResourceDiff) *Network {
@@ -29,7 +58,7 @@ func (l *Network) DiffValidate() error {
 		return err
 	}
 
-	return nil
+	return l.validateIPConfig()
 }
 
 func (l *Network) validateNetworks() error {
@@ -52,3 +81,171 @@ func (l *Network) validateNetworkConfigs(networkTypes string) error {
 
 	return nil
 }
+
+// validateIPConfig validates the gateway, DNS and DHCP addresses are
+// consistent with the cidr, since NSX rejects them only during apply. IPv4
+// and IPv6 configurations of dual stack networks are validated separately.
+// All the violations are returned, each one prefixed with its attribute.
+func (l *Network) validateIPConfig() error {
+	l.errs = nil
+	l.validateIPFamilyConfig(ipv4ConfigPaths)
+	l.validateIPFamilyConfig(ipv6ConfigPaths)
+
+	return errors.Join(l.errs...)
+}
+
+func (l *Network) validateIPFamilyConfig(paths ipConfigPaths) {
+	// cidr is the gateway address of the segment along with the prefix length
+	var subnet netip.Prefix
+	var segmentGateway netip.Addr
+	if cidr, ok := l.getString(paths.cidr); ok {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			l.addError(paths.cidr, "invalid CIDR %s", cidr)
+		} else {
+			segmentGateway, subnet = prefix.Addr(), prefix.Masked()
+		}
+	}
+
+	gateway := l.getAddr(paths.gateway)
+	if gateway.IsValid() && subnet.IsValid() && !subnet.Contains(gateway) {
+		l.addError(paths.gateway, "gateway %s is not within %s %s", gateway, paths.cidr, subnet)
+	}
+
+	primaryDNS := l.getAddr(paths.primaryDNS)
+	secondaryDNS := l.getAddr(paths.secondaryDNS)
+	if secondaryDNS.IsValid() && secondaryDNS == primaryDNS {
+		l.addError(paths.secondaryDNS, "secondary DNS %s is the same as primary DNS", secondaryDNS)
+	}
+
+	start, end := l.getRange(paths.dhcpRange)
+	if start.IsValid() {
+		if subnet.IsValid() && (!subnet.Contains(start) || !subnet.Contains(end)) {
+			l.addError(paths.dhcpRange, "DHCP range %s-%s is not within %s %s", start, end, paths.cidr, subnet)
+		}
+		for _, gw := range []netip.Addr{segmentGateway, gateway} {
+			if inRange(gw, start, end) {
+				l.addError(paths.dhcpRange, "DHCP range %s-%s includes the gateway %s", start, end, gw)
+
+				break
+			}
+		}
+	}
+
+	serverAddress := l.getAddrOrPrefix(paths.dhcpServerAddress)
+	if serverAddress.IsValid() {
+		switch {
+		case subnet.IsValid() && !subnet.Contains(serverAddress):
+			l.addError(paths.dhcpServerAddress, "DHCP server address %s is not within %s %s",
+				serverAddress, paths.cidr, subnet)
+		case inRange(serverAddress, start, end):
+			l.addError(paths.dhcpServerAddress, "DHCP server address %s overlaps the DHCP range %s-%s",
+				serverAddress, start, end)
+		case serverAddress == segmentGateway || serverAddress == gateway:
+			l.addError(paths.dhcpServerAddress, "DHCP server address %s is the same as the gateway", serverAddress)
+		}
+	}
+}
+
+func (l *Network) addError(path, format string, args ...interface{}) {
+	l.errs = append(l.errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, args...)))
+}
+
+// getString returns the value of the attribute, if it is set and known
+// at plan time
+func (l *Network) getString(path string) (string, bool) {
+	if !l.diff.NewValueKnown(path) {
+		return "", false
+	}
+	v, _ := l.diff.Get(path).(string)
+
+	return v, v != ""
+}
+
+// getAddr returns the IP address of the attribute. The returned address is
+// invalid if the attribute is not set, unknown or not an IP address.
+func (l *Network) getAddr(path string) netip.Addr {
+	v, ok := l.getString(path)
+	if !ok {
+		return netip.Addr{}
+	}
+	addr, err := netip.ParseAddr(v)
+	if err != nil {
+		l.addError(path, "invalid IP address %s", v)
+	}
+
+	return addr
+}
+
+// getAddrOrPrefix returns the IP address of the attribute, which is either
+// an IP address or an IP address along with its prefix length
+func (l *Network) getAddrOrPrefix(path string) netip.Addr {
+	v, ok := l.getString(path)
+	if !ok || !strings.Contains(v, "/") {
+		return l.getAddr(path)
+	}
+	prefix, err := netip.ParsePrefix(v)
+	if err != nil {
+		l.addError(path, "invalid CIDR %s", v)
+
+		return netip.Addr{}
+	}
+
+	return prefix.Addr()
+}
+
+// getRange returns the start and end of the IP range of the attribute,
+// e.g. 10.100.0.11-10.100.0.250
+func (l *Network) getRange(path string) (netip.Addr, netip.Addr) {
+	v, ok := l.getString(path)
+	if !ok {
+		return netip.Addr{}, netip.Addr{}
+	}
+	startStr, endStr, _ := strings.Cut(v, "-")
+	start, err1 := netip.ParseAddr(strings.TrimSpace(startStr))
+	end, err2 := netip.ParseAddr(strings.TrimSpace(endStr))
+	switch {
+	case err1 != nil || err2 != nil:
+		l.addError(path, "invalid IP range %s, expected format is <start IP>-<end IP>", v)
+	case start.BitLen() != end.BitLen():
+		l.addError(path, "start and end of the IP range %s are of different IP versions", v)
+	case end.Less(start):
+		l.addError(path, "start of the IP range %s is after the end", v)
+	default:
+		return start, end
+	}
+
+	return netip.Addr{}, netip.Addr{}
+}
+
+// inRange checks whether the IP address is within the range, invalid
+// addresses are not within any range
+func inRange(addr, start, end netip.Addr) bool {
+	if !addr.IsValid() || !start.IsValid() || addr.BitLen() != start.BitLen() {
+		return false
+	}
+
+	return !addr.Less(start) && !end.Less(addr)
+}
+
+// getIPFamily returns IPv4 or IPv6 as the family of the IP address or CIDR
+// of the attribute, if the attribute is set and known at plan time
+func getIPFamily(diff *schema.ResourceDiff, path string) (string, bool) {
+	if !diff.NewValueKnown(path) {
+		return "", false
+	}
+	v, _ := diff.Get(path).(string)
+	addr, err := netip.ParseAddr(v)
+	if err != nil {
+		prefix, err := netip.ParsePrefix(v)
+		if err != nil {
+			return "", false
+		}
+		addr = prefix.Addr()
+	}
+	if addr.Unmap().Is4() {
+		return ipv4, true
+	}
+
+	return ipv6, true
+}
@@ -0,0 +1,178 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package diffvalidation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testDiffValidate plans a new resource of the schema with the config and
+// returns the error of validate
+func testDiffValidate(
+	s map[string]*schema.Schema,
+	config map[string]interface{},
+	validate func(diff *schema.ResourceDiff) error,
+) error {
+	r := &schema.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return validate(diff)
+		},
+	}
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+
+	return err
+}
+
+func testStringSchema() *schema.Schema {
+	return &schema.Schema{Type: schema.TypeString, Optional: true}
+}
+
+func testNetworkSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cidr":               testStringSchema(),
+		"gateway":            testStringSchema(),
+		"primary_dns":        testStringSchema(),
+		"secondary_dns":      testStringSchema(),
+		"cidr_ipv6":          testStringSchema(),
+		"gateway_ipv6":       testStringSchema(),
+		"primary_dns_ipv6":   testStringSchema(),
+		"secondary_dns_ipv6": testStringSchema(),
+		"dhcp_enabled":       {Type: schema.TypeBool, Optional: true},
+		"dhcp_network": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"dhcp_range":               testStringSchema(),
+					"dhcp_server_address":      testStringSchema(),
+					"dhcp_range_ipv6":          testStringSchema(),
+					"dhcp_server_address_ipv6": testStringSchema(),
+				},
+			},
+		},
+	}
+}
+
+func TestNetworkDiffValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "Test case 1: valid DHCP network",
+			config: map[string]interface{}{
+				"cidr":          "10.100.0.1/24",
+				"primary_dns":   "8.8.8.8",
+				"secondary_dns": "8.8.4.4",
+				"dhcp_enabled":  true,
+				"dhcp_network": []interface{}{map[string]interface{}{
+					"dhcp_range":          "10.100.0.11-10.100.0.250",
+					"dhcp_server_address": "10.100.0.2/24",
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test case 2: DHCP configuration with DHCP disabled",
+			config: map[string]interface{}{
+				"cidr":         "10.100.0.1/24",
+				"dhcp_enabled": false,
+				"dhcp_network": []interface{}{map[string]interface{}{
+					"dhcp_range": "10.100.0.11-10.100.0.250",
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test case 3: gateway outside cidr",
+			config: map[string]interface{}{
+				"cidr":    "10.100.0.1/24",
+				"gateway": "10.101.0.1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test case 4: same primary and secondary DNS",
+			config: map[string]interface{}{
+				"primary_dns":   "8.8.8.8",
+				"secondary_dns": "8.8.8.8",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test case 5: DHCP range includes the gateway",
+			config: map[string]interface{}{
+				"cidr":         "10.100.0.1/24",
+				"dhcp_enabled": true,
+				"dhcp_network": []interface{}{map[string]interface{}{
+					"dhcp_range": "10.100.0.1-10.100.0.250",
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test case 6: DHCP server address overlaps DHCP range",
+			config: map[string]interface{}{
+				"cidr":         "10.100.0.1/24",
+				"dhcp_enabled": true,
+				"dhcp_network": []interface{}{map[string]interface{}{
+					"dhcp_range":          "10.100.0.11-10.100.0.250",
+					"dhcp_server_address": "10.100.0.20/24",
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test case 7: valid dual stack network",
+			config: map[string]interface{}{
+				"cidr":         "10.100.0.1/24",
+				"cidr_ipv6":    "2001:db8::1/64",
+				"gateway_ipv6": "2001:db8::1",
+				"dhcp_enabled": true,
+				"dhcp_network": []interface{}{map[string]interface{}{
+					"dhcp_range":      "10.100.0.11-10.100.0.250",
+					"dhcp_range_ipv6": "2001:db8::11-2001:db8::ff",
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test case 8: IPv6 DHCP range outside cidr_ipv6",
+			config: map[string]interface{}{
+				"cidr_ipv6":    "2001:db8::1/64",
+				"dhcp_enabled": true,
+				"dhcp_network": []interface{}{map[string]interface{}{
+					"dhcp_range_ipv6": "2001:db9::11-2001:db9::ff",
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test case 9: invalid DHCP range",
+			config: map[string]interface{}{
+				"dhcp_enabled": true,
+				"dhcp_network": []interface{}{map[string]interface{}{
+					"dhcp_range": "10.100.0.250-10.100.0.11",
+				}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testDiffValidate(testNetworkSchema(), tt.config, func(diff *schema.ResourceDiff) error {
+				return NewNetworkValidate(diff).DiffValidate()
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DiffValidate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
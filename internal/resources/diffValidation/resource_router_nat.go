@@ -19,7 +19,11 @@ func NewRouterNatValidate(diff *schema.ResourceDiff) *RouterNat {
 }
 
 func (r *RouterNat) DiffValidate() error {
-	return r.validateDandSnat()
+	if err := r.validateDandSnat(); err != nil {
+		return err
+	}
+
+	return r.validateIPFamily()
 }
 
 func (r *RouterNat) validateDandSnat() error {
@@ -35,6 +39,33 @@ func (r *RouterNat) validateDandSnat() error {
 			if r.diff.Get("source_network") == "" {
 				return fmt.Errorf("source_network should be set for SNAT")
 			}
+		case "NAT64":
+			if r.diff.Get("destination_network") == "" {
+				return fmt.Errorf("destination_network should be set for NAT64")
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateIPFamily validates the IP families of the networks. NSX supports
+// IPv6 only for NAT64, which translates IPv6 source and destination to IPv4.
+func (r *RouterNat) validateIPFamily() error {
+	action, _ := r.diff.Get("config.0.action").(string)
+	family := map[string]string{
+		"source_network":      ipv4,
+		"destination_network": ipv4,
+		"translated_network":  ipv4,
+	}
+	if action == "NAT64" {
+		family["source_network"] = ipv6
+		family["destination_network"] = ipv6
+	}
+
+	for _, path := range []string{"source_network", "destination_network", "translated_network"} {
+		if f, ok := getIPFamily(r.diff, path); ok && f != family[path] {
+			return fmt.Errorf("%s: %s should be an %s address or CIDR for %s", path, path, family[path], action)
 		}
 	}
 
@@ -0,0 +1,35 @@
+//  (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package diffvalidation
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type RouterRoute struct {
+	diff *schema.ResourceDiff
+}
+
+func NewRouterRouteValidate(diff *schema.ResourceDiff) *RouterRoute {
+	return &RouterRoute{
+		diff: diff,
+	}
+}
+
+func (r *RouterRoute) DiffValidate() error {
+	return r.validateIPFamily()
+}
+
+// validateIPFamily validates the network and next hop of the route are of
+// the same IP family, i.e. IPv6 routes require an IPv6 next hop
+func (r *RouterRoute) validateIPFamily() error {
+	network, ok1 := getIPFamily(r.diff, "network")
+	nextHop, ok2 := getIPFamily(r.diff, "next_hop")
+	if ok1 && ok2 && network != nextHop {
+		return fmt.Errorf("next_hop: next hop should be an %s address for the %s network", network, network)
+	}
+
+	return nil
+}
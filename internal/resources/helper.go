@@ -1,12 +1,87 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
+	"context"
 	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // f for format
 func f(format string, val ...interface{}) string {
 	return fmt.Sprintf(format, val...)
 }
+
+// requireCapability returns a CustomizeDiffFunc which fails the plan if CMP
+// does not support the capability used by the resource. If attributes are
+// given, the plan fails only if any of the attributes is set.
+func requireCapability(capability cmp.Capability, resourceName string, attributes ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		// client may not be configured yet, e.g. while validating the config
+		if _, ok := meta.(map[string]interface{}); !ok {
+			return nil
+		}
+		c, err := client.GetClientFromMetaMap(meta)
+		if err != nil || c.CmpClient == nil {
+			return nil
+		}
+
+		usedBy := resourceName
+		if len(attributes) > 0 {
+			usedBy = ""
+			for _, attr := range attributes {
+				if _, ok := diff.GetOk(attr); ok {
+					usedBy = resourceName + "." + attr
+
+					break
+				}
+			}
+			if usedBy == "" {
+				return nil
+			}
+		}
+
+		capabilities, err := c.CmpClient.Capabilities(ctx)
+		if err != nil {
+			return err
+		}
+
+		return capabilities.Require(capability, usedBy)
+	}
+}
+
+// importChild returns an importer for the resources which are read using the
+// IDs of the parent resources, such as NAT rules of a router. Import ID is in
+// <parent_id>/<id> format, or <parent_id>/.../<id> format if there are more
+// parents, and the parentKeys attributes are set to the parent IDs.
+func importChild(parentKeys ...string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, rd *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			ids := strings.Split(rd.Id(), "/")
+			if len(ids) != len(parentKeys)+1 {
+				return nil, fmt.Errorf("invalid import ID %s, expected <%s>/<id>", rd.Id(),
+					strings.Join(parentKeys, ">/<"))
+			}
+			for i, id := range ids {
+				intID, err := strconv.Atoi(id)
+				if err != nil {
+					return nil, fmt.Errorf("invalid import ID %s, %s is not an integer", rd.Id(), id)
+				}
+				if i < len(parentKeys) {
+					if err := rd.Set(parentKeys[i], intID); err != nil {
+						return nil, err
+					}
+				}
+			}
+			rd.SetId(ids[len(ids)-1])
+
+			return []*schema.ResourceData{rd}, nil
+		},
+	}
+}
@@ -5,6 +5,7 @@ package resources
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -60,6 +61,7 @@ func DhcpServer() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		ReadContext:   DhcpServerReadContext,
+		CustomizeDiff: requireCapability(cmp.CapNsxNetworkAPI, ResDhcpServer),
 		UpdateContext: DhcpServerUpdateContext,
 		CreateContext: DhcpServerCreateContext,
 		DeleteContext: DhcpServerDeleteContext,
@@ -0,0 +1,117 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DistributedFirewallPolicy() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the distributed firewall policy.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description for the distributed firewall policy.",
+			},
+			"priority": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validations.IntAtLeast(1),
+				Description:      "Priority of the policy within the category",
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Application",
+				ForceNew: true,
+				ValidateDiagFunc: validations.StringInSlice([]string{
+					"Ethernet", "Emergency", "Infrastructure", "Environment", "Application",
+				}, false),
+				Description: "Distributed firewall category of the policy. Supported values are `Ethernet`, " +
+					"`Emergency`, `Infrastructure`, `Environment` and `Application`",
+			},
+			"network_server_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "ID of the NSX-T network server of the policy",
+			},
+		},
+		ReadContext:   dfwPolicyReadContext,
+		CreateContext: dfwPolicyCreateContext,
+		UpdateContext: dfwPolicyUpdateContext,
+		DeleteContext: dfwPolicyDeleteContext,
+		CustomizeDiff: requireCapability(cmp.CapNsxNetworkAPI, ResDfwPolicy),
+		Description: `Distributed firewall policy resource facilitates creating, updating
+		and deleting NSX-T distributed firewall security policies.`,
+	}
+}
+
+func dfwPolicyReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DfwPolicy.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func dfwPolicyCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DfwPolicy.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return dfwPolicyReadContext(ctx, rd, meta)
+}
+
+func dfwPolicyUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DfwPolicy.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return dfwPolicyReadContext(ctx, rd, meta)
+}
+
+func dfwPolicyDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DfwPolicy.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
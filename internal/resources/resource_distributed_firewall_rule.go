@@ -0,0 +1,105 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DistributedFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		Schema: schemas.WithAttributes(map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				Description: "ID of the distributed firewall policy of the rule, policy_id can be obtained by using " +
+					ResDfwPolicy + " resource.",
+			},
+			"applied_to": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "NSX group paths which the rule is applied to. Rule is applied to " +
+					"the whole distributed firewall if not set",
+			},
+			"network_server_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				Description: "ID of the NSX-T network server of the policy, network_server_id can be obtained by " +
+					"using " + ResDfwPolicy + " resource.",
+			},
+		}, schemas.FirewallRuleSchema()),
+		ReadContext:   dfwRuleReadContext,
+		CreateContext: dfwRuleCreateContext,
+		UpdateContext: dfwRuleUpdateContext,
+		DeleteContext: dfwRuleDeleteContext,
+		CustomizeDiff: requireCapability(cmp.CapNsxNetworkAPI, ResDfwRule),
+		Description: `Distributed firewall rule resource facilitates creating, updating
+		and deleting rules of NSX-T distributed firewall security policies.`,
+	}
+}
+
+func dfwRuleReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DfwRule.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func dfwRuleCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DfwRule.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return dfwRuleReadContext(ctx, rd, meta)
+}
+
+func dfwRuleUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DfwRule.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return dfwRuleReadContext(ctx, rd, meta)
+}
+
+func dfwRuleDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.DfwRule.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
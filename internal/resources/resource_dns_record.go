@@ -0,0 +1,112 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	diffvalidation "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/diffValidation"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DNSRecord() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				Description: "ID of the network domain of the record. Use " + ResNetworkDomain +
+					" resource or data source to obtain the domain ID.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the record, e.g. the hostname for A records",
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validations.StringInSlice([]string{"A", "AAAA", "CNAME", "PTR"}, false),
+				Description:      "Type of the record. Supported values are `A`, `AAAA`, `CNAME` and `PTR`",
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Content of the record, i.e. the IP address for `A` and `AAAA` records, " +
+					"and the target name for `CNAME` and `PTR` records",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "TTL of the record in seconds. Default TTL of the DNS integration is used if not set",
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Fully qualified domain name of the record",
+			},
+		},
+		ReadContext:   dnsRecordReadContext,
+		CreateContext: dnsRecordCreateContext,
+		DeleteContext: dnsRecordDeleteContext,
+		CustomizeDiff: dnsRecordCustomDiff,
+		Description: `DNS record resource facilitates creating and deleting A, AAAA, CNAME and PTR records
+		of network domains, using the DNS integration of the domain.`,
+	}
+}
+
+func dnsRecordReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResDNSRecord.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func dnsRecordCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResDNSRecord.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return dnsRecordReadContext(ctx, rd, meta)
+}
+
+func dnsRecordDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResDNSRecord.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func dnsRecordCustomDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return diffvalidation.NewDNSRecordValidate(diff).DiffValidate()
+}
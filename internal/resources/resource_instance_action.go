@@ -0,0 +1,161 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func InstanceAction() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the instance on which the action will be performed.",
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: `Action to be performed on the instance. Supported values are 'restart',
+				'reset', 'eject', 'workflow', 'task' and 'refresh_agent'.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					utils.ActionRestart, utils.ActionReset, utils.ActionEject,
+					utils.ActionWorkflow, utils.ActionTask, utils.ActionRefreshAgent,
+				}, false),
+			},
+			"workflow_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the Morpheus workflow to run. Required if action is 'workflow'.",
+			},
+			"task_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the Morpheus task to execute. Required if action is 'task'.",
+			},
+			"custom_options": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Custom options passed to the workflow or task.",
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: `Arbitrary map of values that, when changed, will run the action again.
+				Works the same way as triggers of null_resource.`,
+			},
+			"history_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: `ID of the instance history entry created by the action. For task actions, ID of
+				the history entry of the job execution, or 0 if the task has no history entry.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the instance history entry, or of the job execution for task actions.",
+			},
+			"process_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Process type code of the instance history entry.",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Display name of the instance history entry, or of the job execution for task actions.",
+			},
+			"start_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Start date of the action.",
+			},
+			"end_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "End date of the action.",
+			},
+		},
+		CreateWithoutTimeout: instanceActionCreateContext,
+		ReadContext:          instanceActionReadContext,
+		DeleteContext:        instanceActionDeleteContext,
+		CustomizeDiff:        instanceActionCustomizeDiff,
+		Description: `Instance action resource runs an action such as restart, reset, eject ISO,
+		Morpheus workflow or task and agent refresh on an existing instance. The action is run on
+		creation and whenever any of the arguments, including triggers, changes. Destroying this
+		resource will not revert the action.`,
+	}
+}
+
+func instanceActionCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	switch diff.Get("action").(string) {
+	case utils.ActionWorkflow:
+		if diff.NewValueKnown("workflow_id") && diff.Get("workflow_id").(int) == 0 {
+			return fmt.Errorf("workflow_id is required if action is %s", utils.ActionWorkflow)
+		}
+	case utils.ActionTask:
+		if diff.NewValueKnown("task_id") && diff.Get("task_id").(int) == 0 {
+			return fmt.Errorf("task_id is required if action is %s", utils.ActionTask)
+		}
+	}
+
+	return nil
+}
+
+func instanceActionCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.InstanceAction.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return instanceActionReadContext(ctx, rd, meta)
+}
+
+func instanceActionReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.InstanceAction.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func instanceActionDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.InstanceAction.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -21,6 +21,13 @@ func InstancesClone() *schema.Resource {
 		Description: `Instance ID of the source instance. For getting source instance ID
 		use 'hpeg_vmaas_instance' resource.`,
 	}
+	instanceCloneSchema.Schema["source_snapshot_id"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+		ForceNew: true,
+		Description: `ID of the snapshot of the source instance. If provided, the clone will be
+		created from this snapshot instead of the current state of the source instance.`,
+	}
 	instanceCloneSchema.Description = `Instance clone resource facilitates creating,
 	updating and deleting cloned virtual machines.
 	For creating an instance clone, provide a unique name and all the Mandatory(Required) parameters.
@@ -0,0 +1,225 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// instanceGroupTemplateKeys are the attributes of hpegl_vmaas_instance which
+// are supported in the template of instance group
+var instanceGroupTemplateKeys = []string{
+	"cloud_id", "group_id", "layout_id", "plan_id", "instance_type_code", "network", "volume",
+	"labels", "tags", "config", "evars", "env_prefix", "power_schedule_id", "environment_code",
+}
+
+func InstanceGroup() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name_pattern": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validations.ValidateNamePattern,
+				Description: `Pattern for the name of the members. Pattern should contain a
+				integer verb such as '%d' or '%02d', which will be replaced with the 1 based index of
+				the member, e.g. 'worker-%02d'. Use '%%' for a literal '%'. If the pattern has no '%',
+				'-<index>' will be appended.`,
+			},
+			"instance_count": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				Description:      "Number of instances in the group.",
+				ValidateDiagFunc: validations.IntAtLeast(0),
+			},
+			"max_parallel": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          5,
+				Description:      "Maximum number of instances to be created or deleted in parallel.",
+				ValidateDiagFunc: validations.IntAtLeast(1),
+			},
+			"template": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Description: `Template for the members of the group. Supported attributes are the same as
+				in hpegl_vmaas_instance. Changes in template are applied only on newly created members.`,
+				Elem: &schema.Resource{
+					Schema: instanceGroupTemplateSchema(),
+				},
+			},
+			"instance_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the members, ordered by index.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"member": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Details of the members, ordered by index.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "1 based index of the member.",
+						},
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: f(generalDDesc, "instance"),
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the instance.",
+						},
+						"server_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: f(generalDDesc, "server"),
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the instance.",
+						},
+						"ip_addresses": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "IP addresses of the instance.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+		CreateWithoutTimeout: instanceGroupCreateContext,
+		ReadContext:          instanceGroupReadContext,
+		UpdateWithoutTimeout: instanceGroupUpdateContext,
+		DeleteWithoutTimeout: instanceGroupDeleteContext,
+		CustomizeDiff:        instanceGroupCustomizeDiff,
+		Description: `Instance group resource facilitates creating, scaling and deleting a group
+		of identical instances. Instances are created in parallel from a template, and named
+		using name_pattern. On scaling down, instances with the highest index are removed first.`,
+	}
+}
+
+// instanceGroupTemplateSchema returns the template schema from instance schema. ForceNew
+// is removed, since changes in template do not replace the existing members.
+func instanceGroupTemplateSchema() map[string]*schema.Schema {
+	instanceSchema := getInstanceDefaultSchema(false).Schema
+	templateSchema := make(map[string]*schema.Schema, len(instanceGroupTemplateKeys))
+	for _, k := range instanceGroupTemplateKeys {
+		templateSchema[k] = instanceGroupClearForceNew(instanceSchema[k])
+	}
+	// static IP address can not be shared by the members
+	delete(templateSchema["network"].Elem.(*schema.Resource).Schema, "ip_address")
+
+	return templateSchema
+}
+
+func instanceGroupClearForceNew(s *schema.Schema) *schema.Schema {
+	s.ForceNew = false
+	if r, ok := s.Elem.(*schema.Resource); ok {
+		for _, nested := range r.Schema {
+			instanceGroupClearForceNew(nested)
+		}
+	}
+
+	return s
+}
+
+func instanceGroupCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// member details will change whenever the group is scaled, or missing members
+	// are recreated
+	if diff.HasChange("instance_count") || instanceGroupHasMissingMembers(diff) {
+		if err := diff.SetNewComputed("member"); err != nil {
+			return err
+		}
+
+		return diff.SetNewComputed("instance_ids")
+	}
+
+	return nil
+}
+
+// instanceGroupHasMissingMembers returns true if members are deleted outside of
+// terraform. Those are removed from the state on refresh.
+func instanceGroupHasMissingMembers(diff *schema.ResourceDiff) bool {
+	if diff.Id() == "" {
+		return false
+	}
+	members, _ := diff.Get("member").([]interface{})
+
+	return len(members) < diff.Get("instance_count").(int)
+}
+
+func instanceGroupCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.InstanceGroup.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return instanceGroupReadContext(ctx, rd, meta)
+}
+
+func instanceGroupReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.InstanceGroup.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func instanceGroupUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.InstanceGroup.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return instanceGroupReadContext(ctx, rd, meta)
+}
+
+func instanceGroupDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.InstanceGroup.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testInstanceGroupState returns the state of an instance group with the
+// instance count and members with the given indexes
+func testInstanceGroupState(count int, indexes ...int) *terraform.InstanceState {
+	attrs := map[string]string{
+		"id":             "group",
+		"name_pattern":   "web-%d",
+		"instance_count": strconv.Itoa(count),
+		"max_parallel":   "5",
+		"member.#":       strconv.Itoa(len(indexes)),
+		"instance_ids.#": strconv.Itoa(len(indexes)),
+	}
+	for i, index := range indexes {
+		prefix := "member." + strconv.Itoa(i) + "."
+		attrs[prefix+"index"] = strconv.Itoa(index)
+		attrs[prefix+"id"] = strconv.Itoa(10 + index)
+		attrs[prefix+"name"] = "web-" + strconv.Itoa(index)
+		attrs[prefix+"server_id"] = strconv.Itoa(20 + index)
+		attrs[prefix+"status"] = "running"
+		attrs[prefix+"ip_addresses.#"] = "0"
+		attrs["instance_ids."+strconv.Itoa(i)] = strconv.Itoa(10 + index)
+	}
+
+	return &terraform.InstanceState{ID: "group", Attributes: attrs}
+}
+
+func TestInstanceGroupCustomizeDiff(t *testing.T) {
+	tests := []struct {
+		name      string
+		state     *terraform.InstanceState
+		count     int
+		wantEmpty bool
+	}{
+		{
+			name:      "Test case 1: all members exist",
+			state:     testInstanceGroupState(2, 1, 2),
+			count:     2,
+			wantEmpty: true,
+		},
+		{
+			name:      "Test case 2: member deleted outside of terraform",
+			state:     testInstanceGroupState(2, 2),
+			count:     2,
+			wantEmpty: false,
+		},
+		{
+			name:      "Test case 3: instance count changed",
+			state:     testInstanceGroupState(2, 1, 2),
+			count:     3,
+			wantEmpty: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name_pattern":   "web-%d",
+				"instance_count": tt.count,
+			})
+			diff, err := InstanceGroup().Diff(context.Background(), tt.state, config, nil)
+			if err != nil {
+				t.Fatalf("Diff() error = %v", err)
+			}
+			if diff.Empty() != tt.wantEmpty {
+				t.Errorf("Diff() = %v, want empty %v", diff, tt.wantEmpty)
+			}
+			if !tt.wantEmpty && !diff.Attributes["member.#"].NewComputed {
+				t.Errorf("member is not computed in Diff() = %v", diff)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -39,6 +40,46 @@ func getInstanceDefaultSchema(isClone bool) *schema.Resource {
 	} else {
 		layoutID.Required = true
 	}
+	network := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: f(generalDDesc, "network ID"),
+			},
+			"interface_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: f(generalDDesc, "network interface type"),
+			},
+			"is_primary": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `Flag that identifies if a given network is primary. Primary network cannot be deleted.`,
+			},
+			"internal_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: f(generalDDesc, "network internal ID"),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "name of the interface",
+			},
+		},
+	}
+	if !isClone {
+		// static IP addresses are supported only while provisioning
+		network.Schema["ip_address"] = &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validations.ValidateIPAddress,
+			Description: "Static IP address of the network interface, e.g. the IP address reserved using " +
+				ResIPAddress + " resource. IP address is assigned by DHCP or network pool if not set.",
+		}
+	}
 
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -87,35 +128,7 @@ func getInstanceDefaultSchema(isClone bool) *schema.Resource {
 				MinItems:    1,
 				MaxItems:    5,
 				Description: "Details of the network to which the instance should belong.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: f(generalDDesc, "network ID"),
-						},
-						"interface_id": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: f(generalDDesc, "network interface type"),
-						},
-						"is_primary": {
-							Type:        schema.TypeBool,
-							Computed:    true,
-							Description: `Flag that identifies if a given network is primary. Primary network cannot be deleted.`,
-						},
-						"internal_id": {
-							Type:        schema.TypeInt,
-							Computed:    true,
-							Description: f(generalDDesc, "network internal ID"),
-						},
-						"name": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "name of the interface",
-						},
-					},
-				},
+				Elem:        network,
 			},
 			"volume": {
 				Type:     schema.TypeList,
@@ -294,6 +307,47 @@ func getInstanceDefaultSchema(isClone bool) *schema.Resource {
 					return d.HasChange("power")
 				},
 			},
+			"allow_stop_for_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: `If set to true, a running or suspended instance will be powered off
+				while resizing plan, volumes or networks, and the original power state will be restored
+				after the resize. Defaults to false.`,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: `If set to true, destroying or replacing the instance will fail. Set it
+				to false and apply before destroying the instance. Defaults to false.`,
+			},
+			"preserve_volumes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, volumes of the instance will be preserved on delete. Defaults to false.",
+			},
+			"remove_backups": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If set to true, backups of the instance will be removed on delete. Defaults to true.",
+			},
+			"release_ips": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: `If set to true, IP addresses assigned to the instance will be released
+				on delete. Defaults to true.`,
+			},
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: `If set to true, the instance will be removed even if the delete fails
+				on the underlying cloud. Defaults to false.`,
+			},
 			"snapshot": {
 				Type:     schema.TypeSet,
 				MaxItems: 1,
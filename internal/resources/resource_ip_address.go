@@ -0,0 +1,116 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func IPAddress() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"pool_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				Description: "ID of the network pool from which the IP address is reserved. Use " +
+					ResNetworkPool + " resource or data source to obtain the pool ID.",
+			},
+			"ip_address": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validations.ValidateIPAddress,
+				Description: "IP address to reserve. If not set, the next free IP address of the pool is allocated by CMP. " +
+					"Use the IP address as the static IP address of an instance network or as the `vip_address` of " +
+					ResLoadBalancerVirtualServers + " resource.",
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Hostname of the IP address",
+			},
+			"domain_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				Description: "ID of the network domain of the DNS record. Use " + DSNetworkDomain +
+					" data source or resource to obtain the domain ID. DNS domain of the pool is used if not set.",
+			},
+			"dns_record": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Fully qualified domain name of the IP address",
+			},
+		},
+		ReadContext:   ipAddressReadContext,
+		CreateContext: ipAddressCreateContext,
+		UpdateContext: ipAddressUpdateContext,
+		DeleteContext: ipAddressDeleteContext,
+		Description: `IP address resource facilitates reserving IP addresses of network pools,
+		e.g. for load balancer VIPs and appliances. The reservation is released on destroy.`,
+	}
+}
+
+func ipAddressReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResIPAddress.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func ipAddressCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResIPAddress.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ipAddressReadContext(ctx, rd, meta)
+}
+
+func ipAddressUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResIPAddress.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ipAddressReadContext(ctx, rd, meta)
+}
+
+func ipAddressDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResIPAddress.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
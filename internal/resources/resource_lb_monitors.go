@@ -1,4 +1,4 @@
-// (C) Copyright 2022 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2022-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
@@ -53,6 +53,7 @@ func LoadBalancerMonitor() *schema.Resource {
 			"tcp_monitor":     schemas.TCPMonitorSchema(),
 			"udp_monitor":     schemas.UDPMonitorSchema(),
 		},
+		Importer:      importChild("lb_id"),
 		ReadContext:   loadbalancerMonitorReadContext,
 		UpdateContext: loadbalancerMonitorUpdateContext,
 		CreateContext: loadbalancerMonitorCreateContext,
@@ -1,4 +1,4 @@
-// (C) Copyright 2022 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2022-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
@@ -151,6 +151,7 @@ func LoadBalancerPools() *schema.Resource {
 				},
 			},
 		},
+		Importer:      importChild("lb_id"),
 		ReadContext:   loadbalancerPoolReadContext,
 		UpdateContext: loadbalancerPoolUpdateContext,
 		CreateContext: loadbalancerPoolCreateContext,
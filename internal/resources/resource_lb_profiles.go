@@ -1,4 +1,4 @@
-// (C) Copyright 2022 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2022-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
@@ -79,6 +79,7 @@ func LoadBalancerProfiles() *schema.Resource {
 				},
 			},
 		},
+		Importer:      importChild("lb_id"),
 		ReadContext:   loadbalancerProfileReadContext,
 		UpdateContext: loadbalancerProfileUpdateContext,
 		CreateContext: loadbalancerProfileCreateContext,
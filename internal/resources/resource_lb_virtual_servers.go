@@ -1,4 +1,4 @@
-// (C) Copyright 2022 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2022-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
@@ -34,9 +34,10 @@ func LoadBalancerVirtualServers() *schema.Resource {
 				Description: "Description of Network loadbalancer virtual server",
 			},
 			"vip_address": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Vip_address of Network loadbalancer virtual server",
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "Vip_address of Network loadbalancer virtual server. Use " + ResIPAddress +
+					" resource to reserve the VIP address.",
 			},
 			"vip_port": {
 				Type:        schema.TypeString,
@@ -119,6 +120,7 @@ func LoadBalancerVirtualServers() *schema.Resource {
 				},
 			},
 		},
+		Importer:      importChild("lb_id"),
 		ReadContext:   loadbalancerVirtualServerReadContext,
 		UpdateContext: loadbalancerVirtualServerUpdateContext,
 		CreateContext: loadbalancerVirtualServerCreateContext,
@@ -0,0 +1,146 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func NetworkDomain() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the network domain, e.g. example.com",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the network domain",
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If `true` then the network domain is active",
+			},
+			"visibility": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "private",
+				ValidateDiagFunc: validations.StringInSlice([]string{"private", "public"}, false),
+				Description:      "Visibility of the network domain. Supported values are `private` and `public`",
+			},
+			"public_zone": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If `true` then the domain is a public DNS zone",
+			},
+			"domain_join": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Domain join settings. If set, Windows instances of the domain are joined to the domain controller",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Username of the account used to join the domain",
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Password of the account used to join the domain",
+						},
+						"dc_server": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Domain controller server",
+						},
+						"ou_path": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Organizational unit path of the joined instances, e.g. OU=VMs,DC=example,DC=com",
+						},
+					},
+				},
+			},
+		},
+		SchemaVersion: 0,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		ReadContext:   resNetworkDomainReadContext,
+		CreateContext: resNetworkDomainCreateContext,
+		UpdateContext: resNetworkDomainUpdateContext,
+		DeleteContext: resNetworkDomainDeleteContext,
+		Description: `Network domain resource facilitates creating, updating and deleting network domains.
+		Use the ID of the network domain as the domain of ` + ResNetwork + ` and ` + ResDNSRecord + ` resources.`,
+	}
+}
+
+func resNetworkDomainReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResNetworkDomain.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resNetworkDomainCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResNetworkDomain.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resNetworkDomainReadContext(ctx, rd, meta)
+}
+
+func resNetworkDomainUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResNetworkDomain.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resNetworkDomainReadContext(ctx, rd, meta)
+}
+
+func resNetworkDomainDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResNetworkDomain.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	diffvalidation "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/diffValidation"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func NetworkPool() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the network pool",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Display name of the network pool",
+			},
+			"type_code": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "morpheus",
+				ForceNew:    true,
+				Description: "Type of the network pool. Default is `morpheus`, i.e. IP pool managed by CMP",
+			},
+			"gateway": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validations.ValidateIPAddress,
+				Description:      "Gateway IP address of the network pool",
+			},
+			"dns_servers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of DNS server IP addresses",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validations.ValidateIPAddress,
+				},
+			},
+			"dns_domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "DNS domain of the IP addresses allocated from the pool",
+			},
+			"reverse_dns_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reverse DNS (PTR) zone of the IP addresses allocated from the pool",
+			},
+			"ip_ranges": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "IP address ranges of the pool. Ranges must not overlap each other or the gateway.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_address": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validations.ValidateIPAddress,
+							Description:      "Start IP address of the range",
+						},
+						"end_address": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validations.ValidateIPAddress,
+							Description:      "End IP address of the range",
+						},
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "ID of the range",
+						},
+						"address_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of IP addresses in the range",
+						},
+					},
+				},
+			},
+			"ip_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of IP addresses in the pool",
+			},
+			"free_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of free IP addresses in the pool",
+			},
+		},
+		SchemaVersion: 0,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		ReadContext:   resNetworkPoolReadContext,
+		CreateContext: resNetworkPoolCreateContext,
+		UpdateContext: resNetworkPoolUpdateContext,
+		DeleteContext: resNetworkPoolDeleteContext,
+		CustomizeDiff: networkPoolCustomDiff,
+		Description: `Network pool resource facilitates creating, updating and deleting IP pools.
+		Use the ID of the network pool as ` + "`static_network.pool_id`" + ` of the ` + ResNetwork + ` resource.`,
+	}
+}
+
+func resNetworkPoolReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResNetworkPool.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resNetworkPoolCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResNetworkPool.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resNetworkPoolReadContext(ctx, rd, meta)
+}
+
+func resNetworkPoolUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResNetworkPool.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resNetworkPoolReadContext(ctx, rd, meta)
+}
+
+func resNetworkPoolDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.ResNetworkPool.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func networkPoolCustomDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return diffvalidation.NewNetworkPoolValidate(diff).DiffValidate()
+}
@@ -5,12 +5,14 @@ package resources
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
 	diffvalidation "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/diffValidation"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -86,6 +88,31 @@ func Network() *schema.Resource {
 				Description:      "Gateway Classless Inter-Domain Routing (CIDR) of the network",
 				ValidateDiagFunc: validations.ValidateCidr,
 			},
+			"cidr_ipv6": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Gateway IPv6 CIDR of the network, for dual stack networks. e.g. `2001:db8::1/64`",
+				ValidateDiagFunc: validations.ValidateIPv6Cidr,
+			},
+			"gateway_ipv6": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Gateway IPv6 address of the network",
+				ValidateDiagFunc: validations.ValidateIPv6Address,
+				RequiredWith:     []string{"cidr_ipv6"},
+			},
+			"primary_dns_ipv6": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Primary DNS IPv6 Address",
+				ValidateDiagFunc: validations.ValidateIPv6Address,
+			},
+			"secondary_dns_ipv6": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Secondary DNS IPv6 Address",
+				ValidateDiagFunc: validations.ValidateIPv6Address,
+			},
 			"active": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -118,7 +145,7 @@ func Network() *schema.Resource {
 			"domain_id": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "ID of the Network domain. Use " + DSNetworkDomain + " datasource to obtain the ID.",
+				Description: "ID of the Network domain. Use " + DSNetworkDomain + " datasource or resource to obtain the ID.",
 			},
 			"proxy_id": {
 				Type:        schema.TypeInt,
@@ -202,7 +229,7 @@ func Network() *schema.Resource {
 		CreateContext: resNetworkCreateContext,
 		UpdateContext: resNetworkUpdateContext,
 		DeleteContext: resNetworkDeleteContext,
-		CustomizeDiff: networkCustomDiff,
+		CustomizeDiff: customdiff.All(requireCapability(cmp.CapNsxNetworkAPI, ResNetwork), networkCustomDiff),
 		Description: `Network resource facilitates creating,
 		updating and deleting NSX-T Networks.`,
 	}
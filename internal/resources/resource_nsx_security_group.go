@@ -0,0 +1,151 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func NsxSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the NSX security group.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description for the NSX security group.",
+			},
+			"members": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "NSX paths of the static members, such as segments, VMs or other groups",
+			},
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validations.ValidateIPorCidr,
+				},
+				Description: "IP addresses or CIDRs of the group",
+			},
+			"criteria": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Dynamic membership criteria. Instances matching any of the criteria are members of the group",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validations.StringInSlice([]string{"Tag", "Name"}, false),
+							Description:      "Instance attribute to match. Supported values are `Tag` and `Name`",
+						},
+						"operator": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "EQUALS",
+							ValidateDiagFunc: validations.StringInSlice([]string{
+								"EQUALS", "NOTEQUALS", "CONTAINS", "STARTSWITH", "ENDSWITH",
+							}, false),
+							Description: "Match operator. Supported values are `EQUALS`, `NOTEQUALS`, " +
+								"`CONTAINS`, `STARTSWITH` and `ENDSWITH`",
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: "Value to match. Tags are matched in `scope|tag` format, " +
+								"such as `tier|web`",
+						},
+					},
+				},
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "NSX path of the group, which can be used as source, destination or " +
+					"applied to groups of the firewall rules",
+			},
+			"network_server_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "ID of the NSX-T network server of the group",
+			},
+		},
+		ReadContext:   nsxSecurityGroupReadContext,
+		CreateContext: nsxSecurityGroupCreateContext,
+		UpdateContext: nsxSecurityGroupUpdateContext,
+		DeleteContext: nsxSecurityGroupDeleteContext,
+		CustomizeDiff: requireCapability(cmp.CapNsxNetworkAPI, ResNsxSecurityGroup),
+		Description: `NSX security group resource facilitates creating, updating and deleting
+		NSX-T groups with static members, IP addresses and dynamic membership criteria.`,
+	}
+}
+
+func nsxSecurityGroupReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.NsxSecurityGroup.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func nsxSecurityGroupCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.NsxSecurityGroup.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nsxSecurityGroupReadContext(ctx, rd, meta)
+}
+
+func nsxSecurityGroupUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.NsxSecurityGroup.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nsxSecurityGroupReadContext(ctx, rd, meta)
+}
+
+func nsxSecurityGroupDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.NsxSecurityGroup.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
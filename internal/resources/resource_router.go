@@ -1,15 +1,17 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
 	diffvalidation "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/diffValidation"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -77,11 +79,14 @@ func Router() *schema.Resource {
 			"tier0_config": schemas.RouterTier0ConfigSchema(),
 			"tier1_config": schemas.RouterTier1ConfigSchema(),
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		ReadContext:   routerReadContext,
 		CreateContext: routerCreateContext,
 		UpdateContext: routerUpdateContext,
 		DeleteContext: routerDeleteContext,
-		CustomizeDiff: routerCustomDiff,
+		CustomizeDiff: customdiff.All(requireCapability(cmp.CapRouterAPI, ResRouter), routerCustomDiff),
 		Description: `Router resource facilitates creating,
 		updating and deleting NSX-T Tier0/Tier1 Network Routers.`,
 	}
@@ -1,10 +1,11 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
@@ -120,7 +121,9 @@ func RouterBgpNeighbor() *schema.Resource {
 				},
 			},
 		},
+		Importer:      importChild("router_id"),
 		ReadContext:   routerBgpNeighborReadContext,
+		CustomizeDiff: requireCapability(cmp.CapRoutingAPI, ResRouterBgpNeighbor),
 		CreateContext: routerBgpNeighborCreateContext,
 		UpdateContext: routerBgpNeighborUpdateContext,
 		DeleteContext: routerBgpNeighborDeleteContext,
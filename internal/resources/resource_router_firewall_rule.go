@@ -0,0 +1,98 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package resources
+
+import (
+	"context"
+
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/schemas"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func RouterFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		Schema: schemas.WithAttributes(map[string]*schema.Schema{
+			"router_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Parent router ID, router_id can be obtained by using router datasource/resource.",
+			},
+			"group_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				Description: "ID of the firewall rule group of the rule, group_id can be obtained by using " +
+					ResRouterFirewallRuleGroup + " resource.",
+			},
+		}, schemas.FirewallRuleSchema()),
+		Importer:      importChild("router_id", "group_id"),
+		ReadContext:   routerFirewallRuleReadContext,
+		CreateContext: routerFirewallRuleCreateContext,
+		UpdateContext: routerFirewallRuleUpdateContext,
+		DeleteContext: routerFirewallRuleDeleteContext,
+		CustomizeDiff: requireCapability(cmp.CapRouterAPI, ResRouterFirewallRule),
+		Description: `Router firewall rule resource facilitates creating, updating
+		and deleting rules of NSX-T Network Router firewall rule groups.`,
+	}
+}
+
+func routerFirewallRuleReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterFirewallRule.Read(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func routerFirewallRuleCreateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterFirewallRule.Create(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerFirewallRuleReadContext(ctx, rd, meta)
+}
+
+func routerFirewallRuleUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterFirewallRule.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return routerFirewallRuleReadContext(ctx, rd, meta)
+}
+
+func routerFirewallRuleDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterFirewallRule.Delete(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -1,10 +1,11 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
@@ -34,12 +35,14 @@ func RouterFirewallRuleGroup() *schema.Resource {
 			"priority": {
 				Type:             schema.TypeInt,
 				Optional:         true,
+				Computed:         true,
 				Description:      "Firewall rule group priority",
 				ValidateDiagFunc: validations.IntAtLeast(1),
 			},
 			"group_layer": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 				ValidateDiagFunc: validations.StringInSlice([]string{
 					"Emergency",
 					"SharedPreRules",
@@ -48,7 +51,9 @@ func RouterFirewallRuleGroup() *schema.Resource {
 				Description: "Platform/vendor specific category",
 			},
 		},
+		Importer:      importChild("router_id"),
 		ReadContext:   routerFirewallRuleGroupReadContext,
+		CustomizeDiff: requireCapability(cmp.CapRouterAPI, ResRouterFirewallRuleGroup),
 		CreateContext: routerFirewallRuleGroupCreateContext,
 		UpdateContext: routerFirewallRuleGroupUpdateContext,
 		DeleteContext: routerFirewallRuleGroupDeleteContext,
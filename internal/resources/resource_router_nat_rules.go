@@ -1,15 +1,17 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package resources
 
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
 	diffvalidation "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/diffValidation"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -48,10 +50,11 @@ func RouterNatRule() *schema.Resource {
 						"action": {
 							Type: schema.TypeString,
 							ValidateDiagFunc: validations.StringInSlice([]string{
-								"DNAT", "SNAT",
+								"DNAT", "SNAT", "NAT64",
 							}, false),
-							Required:    true,
-							Description: "NAT Rule Type. Supported values are `DNAT` and `SNAT`",
+							Required: true,
+							Description: "NAT Rule Type. Supported values are `DNAT`, `SNAT` and `NAT64`. " +
+								"`NAT64` translates IPv6 destination to IPv4, and is supported only on Tier1 routers",
 						},
 						"service": {
 							Type:        schema.TypeString,
@@ -87,14 +90,14 @@ func RouterNatRule() *schema.Resource {
 			"source_network": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				ValidateDiagFunc: validations.ValidateIPorCidr,
-				Description:      "Source Network CIDR/IPv4 Address",
+				ValidateDiagFunc: validations.ValidateDualStackIPorCidr,
+				Description:      "Source Network CIDR/IP Address. IPv6 is supported only for NAT64",
 			},
 			"destination_network": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				ValidateDiagFunc: validations.ValidateIPorCidr,
-				Description:      "Destination Network CIDR/IPv4 Address",
+				ValidateDiagFunc: validations.ValidateDualStackIPorCidr,
+				Description:      "Destination Network CIDR/IP Address. IPv6 is supported only for NAT64",
 			},
 			"translated_network": {
 				Type:             schema.TypeString,
@@ -115,11 +118,12 @@ func RouterNatRule() *schema.Resource {
 				ValidateDiagFunc: validations.IntAtLeast(1),
 			},
 		},
+		Importer:      importChild("router_id"),
 		ReadContext:   routerNatRuleReadContext,
 		CreateContext: routerNatRuleCreateContext,
 		UpdateContext: routerNatRuleUpdateContext,
 		DeleteContext: routerNatRuleDeleteContext,
-		CustomizeDiff: routerNatCustomDiff,
+		CustomizeDiff: customdiff.All(requireCapability(cmp.CapRouterAPI, ResRouterNat), routerNatCustomDiff),
 		Description: `Router NAT rule resource facilitates creating,
 		updating and deleting NSX-T Network Router NAT rules.`,
 	}
@@ -5,10 +5,13 @@ package resources
 import (
 	"context"
 
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/cmp"
+	diffvalidation "github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/diffValidation"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/utils"
 	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,20 +28,17 @@ func RouterRoute() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				Description: "Name of the route.",
-				ForceNew:    true,
 			},
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Description for the route.",
-				ForceNew:    true,
 			},
 			"enabled": {
 				Type:        schema.TypeBool,
 				Default:     true,
 				Optional:    true,
 				Description: "If `true` then route will be active/enabled.",
-				ForceNew:    true,
 			},
 			"default_route": {
 				Type:        schema.TypeBool,
@@ -51,21 +51,19 @@ func RouterRoute() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ValidateDiagFunc: validations.ValidateCidr,
-				Description:      "Source Network CIDR Address",
+				Description:      "Source Network IPv4 or IPv6 CIDR Address",
 				ForceNew:         true,
 			},
 			"next_hop": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateDiagFunc: validations.ValidateIPAddress,
-				Description:      "Next Hop/Destination IPv4 Address",
-				ForceNew:         true,
+				ValidateDiagFunc: validations.ValidateDualStackIPAddress,
+				Description:      "Next Hop/Destination IPv4 or IPv6 Address, of the same IP family as network",
 			},
 			"mtu": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Description: "Network MTU",
-				ForceNew:    true,
 			},
 			"priority": {
 				Type:             schema.TypeInt,
@@ -73,7 +71,6 @@ func RouterRoute() *schema.Resource {
 				Default:          100,
 				Description:      "Priority for the route",
 				ValidateDiagFunc: validations.IntAtLeast(1),
-				ForceNew:         true,
 			},
 			"is_deprecated": {
 				Type:        schema.TypeBool,
@@ -101,14 +98,21 @@ func RouterRoute() *schema.Resource {
 				Computed: true,
 			},
 		},
+		Importer:      importChild("router_id"),
 		ReadContext:   routerRouteReadContext,
+		CustomizeDiff: customdiff.All(requireCapability(cmp.CapRoutingAPI, ResRouterRoute), routerRouteCustomDiff),
 		CreateContext: routerRouteCreateContext,
+		UpdateContext: routerRouteUpdateContext,
 		DeleteContext: routerRouteDeleteContext,
 		Description: `Router route resource facilitates creating,
 		updating and deleting NSX-T Network Router routes.`,
 	}
 }
 
+func routerRouteCustomDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return diffvalidation.NewRouterRouteValidate(diff).DiffValidate()
+}
+
 func routerRouteReadContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c, err := client.GetClientFromMetaMap(meta)
 	if err != nil {
@@ -146,19 +150,19 @@ func routerRouteCreateContext(ctx context.Context, rd *schema.ResourceData, meta
 	return routerRouteReadContext(ctx, rd, meta)
 }
 
-// func routerRouteUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
-// 	c, err := client.GetClientFromMetaMap(meta)
-// 	if err != nil {
-// 		return diag.FromErr(err)
-// 	}
+func routerRouteUpdateContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := client.GetClientFromMetaMap(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-// 	data := utils.NewData(rd)
-// 	if err := c.CmpClient.RouterRoute.Update(ctx, data, meta); err != nil {
-// 		return diag.FromErr(err)
-// 	}
+	data := utils.NewData(rd)
+	if err := c.CmpClient.RouterRoute.Update(ctx, data, meta); err != nil {
+		return diag.FromErr(err)
+	}
 
-// 	return routerRouteReadContext(ctx, rd, meta)
-// }
+	return routerRouteReadContext(ctx, rd, meta)
+}
 
 func routerRouteDeleteContext(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c, err := client.GetClientFromMetaMap(meta)
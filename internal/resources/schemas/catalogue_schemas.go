@@ -0,0 +1,532 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Catalogue attribute schemas are the computed attributes exposed by
+// lookup data sources, such as network, plan and template.
+
+func computedAttribute(valueType schema.ValueType, description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        valueType,
+		Computed:    true,
+		Description: description,
+	}
+}
+
+func NetworkAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"display_name":  computedAttribute(schema.TypeString, "Display name of the network."),
+		"description":   computedAttribute(schema.TypeString, "Description of the network."),
+		"external_id":   computedAttribute(schema.TypeString, "External ID of the network."),
+		"cidr":          computedAttribute(schema.TypeString, "CIDR of the network."),
+		"gateway":       computedAttribute(schema.TypeString, "Gateway IP address of the network."),
+		"primary_dns":   computedAttribute(schema.TypeString, "Primary DNS server of the network."),
+		"secondary_dns": computedAttribute(schema.TypeString, "Secondary DNS server of the network."),
+		"vlan_id":       computedAttribute(schema.TypeInt, "VLAN ID of the network."),
+		"dhcp_server":   computedAttribute(schema.TypeBool, "Whether DHCP server is enabled on the network."),
+		"active":        computedAttribute(schema.TypeBool, "Whether the network is active."),
+		"status":        computedAttribute(schema.TypeString, "Status of the network."),
+		"visibility":    computedAttribute(schema.TypeString, "Visibility of the network."),
+		"type_code":     computedAttribute(schema.TypeString, "Code of the network type."),
+		"cloud_id":      computedAttribute(schema.TypeInt, "ID of the cloud which the network belongs to."),
+		"pool_id":       computedAttribute(schema.TypeInt, "ID of the network pool attached to the network."),
+		"pool_name":     computedAttribute(schema.TypeString, "Name of the network pool attached to the network."),
+		"domain_id":     computedAttribute(schema.TypeInt, "ID of the network domain attached to the network."),
+	}
+}
+
+func PlanAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"code":             computedAttribute(schema.TypeString, "Code of the plan."),
+		"active":           computedAttribute(schema.TypeBool, "Whether the plan is active."),
+		"provision_type":   computedAttribute(schema.TypeString, "Provision type code of the plan."),
+		"max_cores":        computedAttribute(schema.TypeInt, "Number of cores of the plan."),
+		"cores_per_socket": computedAttribute(schema.TypeInt, "Number of cores per socket of the plan."),
+		"max_memory":       computedAttribute(schema.TypeInt, "Memory of the plan in bytes."),
+		"max_storage":      computedAttribute(schema.TypeInt, "Storage of the plan in bytes."),
+		"max_disks":        computedAttribute(schema.TypeInt, "Maximum number of disks of the plan."),
+		"sort_order":       computedAttribute(schema.TypeInt, "Sort order of the plan."),
+		"custom_cpu":       computedAttribute(schema.TypeBool, "Whether the number of CPUs can be customized."),
+		"custom_cores":     computedAttribute(schema.TypeBool, "Whether the number of cores can be customized."),
+		"custom_max_memory": computedAttribute(schema.TypeBool,
+			"Whether the memory can be customized up to max_memory."),
+		"custom_max_storage": computedAttribute(schema.TypeBool,
+			"Whether the root volume size can be customized up to max_storage."),
+		"custom_max_data_storage": computedAttribute(schema.TypeBool,
+			"Whether the data volume sizes can be customized."),
+		"add_volumes": computedAttribute(schema.TypeBool, "Whether volumes can be added to the plan."),
+		"root_disk_customizable": computedAttribute(schema.TypeBool,
+			"Whether the root disk of the provision type is customizable."),
+		"configurable_cpu_sockets": computedAttribute(schema.TypeBool,
+			"Whether the number of CPU sockets of the provision type is configurable."),
+	}
+}
+
+// PlanSizingSchema returns the arguments used to select the smallest plan
+// satisfying the sizing requirements
+func PlanSizingSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"min_cores": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Minimum number of cores of the plan.",
+		},
+		"min_memory_gb": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Minimum memory of the plan in GB.",
+		},
+		"min_storage_gb": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Minimum storage of the plan in GB.",
+		},
+	}
+}
+
+func TemplateAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"description":   computedAttribute(schema.TypeString, "Description of the template."),
+		"external_id":   computedAttribute(schema.TypeString, "External ID of the template."),
+		"image_type":    computedAttribute(schema.TypeString, "Image type of the template, such as 'vmware' or 'ova'."),
+		"status":        computedAttribute(schema.TypeString, "Status of the template."),
+		"is_cloud_init": computedAttribute(schema.TypeBool, "Whether cloud-init is enabled on the template."),
+		"min_memory":    computedAttribute(schema.TypeInt, "Minimum memory required by the template in bytes."),
+		"min_disk":      computedAttribute(schema.TypeInt, "Minimum disk size required by the template in bytes."),
+		"size":          computedAttribute(schema.TypeInt, "Size of the template in bytes."),
+		"date_created":  computedAttribute(schema.TypeString, "Creation date of the template."),
+		"os_type":       computedAttribute(schema.TypeString, "Code of the OS type of the template."),
+		"os_name":       computedAttribute(schema.TypeString, "Name of the OS type of the template."),
+		"os_family":     computedAttribute(schema.TypeString, "OS family of the template, such as 'rhel' or 'windows'."),
+		"os_version":    computedAttribute(schema.TypeString, "OS version of the template."),
+		"os_platform":   computedAttribute(schema.TypeString, "OS platform of the template, such as 'linux'."),
+		"os_bit_count":  computedAttribute(schema.TypeInt, "OS architecture bit count of the template."),
+		"tags": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "Tags of the template.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+// TemplateFilterSchema returns the arguments used to filter the templates.
+// computed is set for lookup data source, where the arguments are also
+// populated from the selected template.
+func TemplateFilterSchema(computed bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name_prefix": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Prefix of the template name, such as 'rhel9-'.",
+		},
+		"os_type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    computed,
+			Description: "Code of the OS type of the template, such as 'rhel.9.64'.",
+		},
+		"image_type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    computed,
+			Description: "Image type of the template, such as 'vmware' or 'ova'.",
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: computed,
+			Description: "Tags which the template must have. An empty value matches the tag " +
+				"with any value.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+func DatastoreAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type":        computedAttribute(schema.TypeString, "Type of the datastore."),
+		"external_id": computedAttribute(schema.TypeString, "External ID of the datastore."),
+		"capacity":    computedAttribute(schema.TypeInt, "Capacity of the datastore in bytes."),
+		"free_space":  computedAttribute(schema.TypeInt, "Free space of the datastore in bytes."),
+		"online":      computedAttribute(schema.TypeBool, "Whether the datastore is online."),
+		"active":      computedAttribute(schema.TypeBool, "Whether the datastore is active."),
+		"visibility":  computedAttribute(schema.TypeString, "Visibility of the datastore."),
+		"tags": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "Tags of the datastore.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+// DatastoreFilterSchema returns the arguments used to filter the datastores.
+// computed is set for lookup data source, where the arguments are also
+// populated from the selected datastore.
+func DatastoreFilterSchema(computed bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name_prefix": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Prefix of the datastore name.",
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: computed,
+			Description: "Type of the datastore, such as 'vmfs' or 'nfs'. Datastore clusters are " +
+				"listed along with the datastores and can be selected using their type.",
+		},
+		"tags": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Computed:    computed,
+			Description: "Tags which the datastore must have. An empty value matches the tag with any value.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"min_free_gb": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Minimum free space of the datastore in GB.",
+		},
+	}
+}
+
+func CloudAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"code":        computedAttribute(schema.TypeString, "Code of the cloud."),
+		"location":    computedAttribute(schema.TypeString, "Location of the cloud."),
+		"type_code":   computedAttribute(schema.TypeString, "Code of the cloud type."),
+		"status":      computedAttribute(schema.TypeString, "Status of the cloud."),
+		"enabled":     computedAttribute(schema.TypeBool, "Whether the cloud is enabled."),
+		"region_code": computedAttribute(schema.TypeString, "Region code of the cloud."),
+		"visibility":  computedAttribute(schema.TypeString, "Visibility of the cloud."),
+	}
+}
+
+func GroupAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"code":     computedAttribute(schema.TypeString, "Code of the group."),
+		"location": computedAttribute(schema.TypeString, "Location of the group."),
+		"active":   computedAttribute(schema.TypeBool, "Whether the group is active."),
+		"cloud_ids": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "IDs of the clouds attached to the group.",
+			Elem: &schema.Schema{
+				Type: schema.TypeInt,
+			},
+		},
+	}
+}
+
+func PowerScheduleAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"description":       computedAttribute(schema.TypeString, "Description of the power schedule."),
+		"enabled":           computedAttribute(schema.TypeBool, "Whether the power schedule is enabled."),
+		"schedule_type":     computedAttribute(schema.TypeString, "Type of the power schedule, such as 'power'."),
+		"schedule_timezone": computedAttribute(schema.TypeString, "Timezone of the power schedule."),
+	}
+}
+
+// WithAttributes adds the catalogue attributes to the given data source schema
+func WithAttributes(
+	dsSchema map[string]*schema.Schema,
+	attributes map[string]*schema.Schema,
+) map[string]*schema.Schema {
+	for k, v := range attributes {
+		dsSchema[k] = v
+	}
+
+	return dsSchema
+}
+
+func RouterAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"code":        computedAttribute(schema.TypeString, "Code of the router."),
+		"router_type": computedAttribute(schema.TypeString, "Type of the router, such as 'Tier-1 Gateway'."),
+		"provider_id": computedAttribute(schema.TypeString, "Provider ID of the router."),
+		"external_id": computedAttribute(schema.TypeString, "External ID of the router."),
+		"status":      computedAttribute(schema.TypeString, "Status of the router."),
+		"enabled":     computedAttribute(schema.TypeBool, "Whether the router is enabled."),
+		"cloud_id":    computedAttribute(schema.TypeInt, "ID of the cloud which the router belongs to."),
+		"interfaces": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Interface Configuration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id":               computedAttribute(schema.TypeInt, "ID of the Uplink Interface"),
+					"source_addresses": computedAttribute(schema.TypeString, "Interface IP Address of the Uplink Interface"),
+					"cidr":             computedAttribute(schema.TypeString, "CIDR of the network the Uplink Interface"),
+				},
+			},
+		},
+	}
+}
+
+func LoadBalancerAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"description": computedAttribute(schema.TypeString, "Description of the load balancer."),
+		"type_code":   computedAttribute(schema.TypeString, "Code of the load balancer type."),
+		"host":        computedAttribute(schema.TypeString, "Host of the load balancer."),
+		"ip":          computedAttribute(schema.TypeString, "IP address of the load balancer."),
+		"port":        computedAttribute(schema.TypeInt, "Port of the load balancer."),
+		"ssl_enabled": computedAttribute(schema.TypeBool, "Whether SSL is enabled on the load balancer."),
+		"enabled":     computedAttribute(schema.TypeBool, "Whether the load balancer is enabled."),
+		"visibility":  computedAttribute(schema.TypeString, "Visibility of the load balancer."),
+		"cloud_id":    computedAttribute(schema.TypeInt, "ID of the cloud which the load balancer belongs to."),
+	}
+}
+
+func EnvironmentAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"code": computedAttribute(schema.TypeString, "code of each environment"),
+	}
+}
+
+func NetworkTypeAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"code":        computedAttribute(schema.TypeString, "Code of the network type."),
+		"description": computedAttribute(schema.TypeString, "Description of the network type."),
+		"category":    computedAttribute(schema.TypeString, "Category of the network type."),
+	}
+}
+
+func NetworkPoolAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"display_name": computedAttribute(schema.TypeString, "Display name of the network pool"),
+		"code":         computedAttribute(schema.TypeString, "Code of the network pool."),
+		"category":     computedAttribute(schema.TypeString, "Category of the network pool."),
+		"external_id":  computedAttribute(schema.TypeString, "External ID of the network pool."),
+	}
+}
+
+func DomainAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"active":            computedAttribute(schema.TypeBool, "Flag denotes active domain or not"),
+		"visibility":        computedAttribute(schema.TypeString, "Visibility of the network domain."),
+		"domain_controller": computedAttribute(schema.TypeBool, "Whether the network domain is a domain controller."),
+		"public_zone":       computedAttribute(schema.TypeBool, "Whether the network domain is a public zone."),
+	}
+}
+
+func NetworkProxyAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"proxy_host": computedAttribute(schema.TypeString, "Host of the network proxy."),
+		"proxy_port": computedAttribute(schema.TypeInt, "Port of the network proxy."),
+		"visibility": computedAttribute(schema.TypeString, "Visibility of the network proxy."),
+	}
+}
+
+func ResourcePoolAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type":         computedAttribute(schema.TypeString, "Type of the resource pool."),
+		"external_id":  computedAttribute(schema.TypeString, "External ID of the resource pool."),
+		"parent_id":    computedAttribute(schema.TypeInt, "ID of the parent resource pool."),
+		"default_pool": computedAttribute(schema.TypeBool, "Whether the resource pool is the default pool of the cloud."),
+		"active":       computedAttribute(schema.TypeBool, "Whether the resource pool is active."),
+		"status":       computedAttribute(schema.TypeString, "Status of the resource pool."),
+		"visibility":   computedAttribute(schema.TypeString, "Visibility of the resource pool."),
+	}
+}
+
+func CloudFolderAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"code":           computedAttribute(schema.TypeString, "External ID or code for the folder."),
+		"type":           computedAttribute(schema.TypeString, "Type of the folder."),
+		"default_folder": computedAttribute(schema.TypeBool, "Whether the folder is the default folder of the cloud."),
+		"default_store":  computedAttribute(schema.TypeBool, "Whether the folder is the default store of the cloud."),
+		"read_only":      computedAttribute(schema.TypeBool, "Whether the folder is read only."),
+		"active":         computedAttribute(schema.TypeBool, "Whether the folder is active."),
+		"visibility":     computedAttribute(schema.TypeString, "Visibility of the folder."),
+	}
+}
+
+func LayoutAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"code":               computedAttribute(schema.TypeString, "Code of the layout."),
+		"instance_version":   computedAttribute(schema.TypeString, "Version of the instance type of the layout."),
+		"memory_requirement": computedAttribute(schema.TypeInt, "Memory required by the layout in bytes."),
+		"sort_order":         computedAttribute(schema.TypeInt, "Sort order of the layout."),
+		"creatable":          computedAttribute(schema.TypeBool, "Whether instances can be created using the layout."),
+	}
+}
+
+func EdgeClusterAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"display_name": computedAttribute(schema.TypeString, "Display name of the edge cluster."),
+		"description":  computedAttribute(schema.TypeString, "Description of the edge cluster."),
+		"internal_id":  computedAttribute(schema.TypeString, "Internal ID of the edge cluster."),
+		"external_id":  computedAttribute(schema.TypeString, "External ID of the edge cluster."),
+		"provider_id": computedAttribute(schema.TypeString, "ProviderId of the Edge Cluster. "+
+			"Use the provider_id as EdgeCluster while creating NSX-T Router"),
+		"enabled":    computedAttribute(schema.TypeBool, "Whether the edge cluster is enabled."),
+		"active":     computedAttribute(schema.TypeBool, "Whether the edge cluster is active."),
+		"visibility": computedAttribute(schema.TypeString, "Visibility of the edge cluster."),
+		"cloud_id":   computedAttribute(schema.TypeInt, "ID of the cloud which the edge cluster belongs to."),
+	}
+}
+
+func TransportZoneAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"display_name": computedAttribute(schema.TypeString, "Display name of the transport zone."),
+		"internal_id":  computedAttribute(schema.TypeString, "Internal ID of the transport zone."),
+		"external_id":  computedAttribute(schema.TypeString, "External ID of the transport zone."),
+		"provider_id": computedAttribute(schema.TypeString, "scope_id of the transport zone. "+
+			"Use the scope_id as transport zone while creating network"),
+		"status":     computedAttribute(schema.TypeString, "Status of the transport zone."),
+		"enabled":    computedAttribute(schema.TypeBool, "Whether the transport zone is enabled."),
+		"active":     computedAttribute(schema.TypeBool, "Whether the transport zone is active."),
+		"visibility": computedAttribute(schema.TypeString, "Visibility of the transport zone."),
+		"cloud_id":   computedAttribute(schema.TypeInt, "ID of the cloud which the transport zone belongs to."),
+	}
+}
+
+func DHCPServerAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"provider_id": computedAttribute(schema.TypeString, "ProviderId of the DHCP Server. "+
+			"Use the provider_id  while creating DHCP NSX-T Segment Network"),
+		"external_id":       computedAttribute(schema.TypeString, "External ID of the DHCP server."),
+		"server_ip_address": computedAttribute(schema.TypeString, "IP address of the DHCP server."),
+		"lease_time":        computedAttribute(schema.TypeInt, "Lease time of the DHCP server in seconds."),
+	}
+}
+
+// CatalogueListSchema returns the schema of plural data sources. ids contains
+// the IDs of all the objects and listAttribute contains the objects with the
+// given attributes along with id and name.
+func CatalogueListSchema(
+	listAttribute, kind string,
+	arguments, attributes map[string]*schema.Schema,
+) map[string]*schema.Schema {
+	itemSchema := WithAttributes(map[string]*schema.Schema{
+		"id":   computedAttribute(schema.TypeInt, "ID of the "+kind+"."),
+		"name": computedAttribute(schema.TypeString, "Name of the "+kind+"."),
+	}, attributes)
+
+	return WithAttributes(map[string]*schema.Schema{
+		"name_regex": NameRegexSchema(kind + "s"),
+		"filter":     FilterSchema(),
+		"phrase": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Search phrase. Only the " + kind + "s matching the phrase will be listed.",
+		},
+		"ids": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "IDs of the " + kind + "s.",
+			Elem: &schema.Schema{
+				Type: schema.TypeInt,
+			},
+		},
+		listAttribute: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "List of the " + kind + "s.",
+			Elem: &schema.Resource{
+				Schema: itemSchema,
+			},
+		},
+	}, arguments)
+}
+
+// LookupSchema returns the schema of lookup data sources, which returns a
+// single object. The object can be looked up using id, name, name_regex or
+// filter blocks. If more than one object matches, most_recent can be used to
+// select the most recently created one.
+// selectors are the additional arguments which can be used to look up the
+// object, such as min_cores of plan.
+func LookupSchema(
+	kind string,
+	arguments, attributes map[string]*schema.Schema,
+	selectors ...string,
+) map[string]*schema.Schema {
+	lookupArgs := append([]string{"id", "name", "name_regex", "filter"}, selectors...)
+
+	// arguments take precedence over the attributes with the same name, such
+	// as provision_type of plan
+	return WithAttributes(WithAttributes(map[string]*schema.Schema{
+		"id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: lookupArgs,
+			Description:  "ID of the " + kind + ".",
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: lookupArgs,
+			Description:  "Name of the " + kind + " as it appears on HPE GreenLake for private cloud dashboard.",
+		},
+		"name_regex": NameRegexSchema(kind),
+		"filter":     FilterSchema(),
+		"most_recent": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+			Description: "If more than one " + kind + " matches the arguments, use the most recently " +
+				"created one. Otherwise more than one match results in an error.",
+		},
+	}, attributes), arguments)
+}
+
+func NameRegexSchema(kind string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Regular expression to match the name of the " + kind + ".",
+		ValidateFunc: validation.StringIsValidRegExp,
+	}
+}
+
+// FilterSchema returns the schema of filter blocks. Filter name is either an
+// attribute of the data source or a query param supported by CMP, such as phrase.
+func FilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Description: `Filter on any of the attributes of the data source. Filters supported by CMP,
+		such as 'phrase', are sent to CMP and others are matched after fetching. Multiple values of
+		a filter are ORed and multiple filters are ANDed.`,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the filter, such as 'cidr' or 'os_type'.",
+				},
+				"values": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Description: "Values of the filter. Matches if the attribute is equal to any of the values.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
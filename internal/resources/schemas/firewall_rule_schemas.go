@@ -0,0 +1,132 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package schemas
+
+import (
+	"github.com/HewlettPackard/hpegl-vmaas-terraform-resources/internal/resources/validations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// FirewallRuleSchema returns the attributes of a firewall rule, which are
+// shared by the gateway and the distributed firewall rules
+func FirewallRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the firewall rule.",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Description for the firewall rule.",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "If `true` then firewall rule will be active/enabled.",
+		},
+		"priority": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validations.IntAtLeast(1),
+			Description:      "Priority of the rule within the firewall rule group",
+		},
+		"action": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateDiagFunc: validations.StringInSlice([]string{
+				"ALLOW", "DROP", "REJECT",
+			}, false),
+			Description: "Action of the rule. Supported values are `ALLOW`, `DROP` and `REJECT`",
+		},
+		"direction": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "IN_OUT",
+			ValidateDiagFunc: validations.StringInSlice([]string{
+				"IN", "OUT", "IN_OUT",
+			}, false),
+			Description: "Direction of the traffic. Supported values are `IN`, `OUT` and `IN_OUT`",
+		},
+		"logging": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Enable/Disable Logging",
+		},
+		"source_groups": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "NSX group paths of the source. Any source is matched if no source is set",
+		},
+		"source_addresses": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validations.ValidateIPorCidr,
+			},
+			Description: "Source IP addresses or CIDRs",
+		},
+		"destination_groups": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Description: "NSX group paths of the destination. Any destination is matched " +
+				"if no destination is set",
+		},
+		"destination_addresses": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validations.ValidateIPorCidr,
+			},
+			Description: "Destination IP addresses or CIDRs",
+		},
+		"services": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Description: "NSX service paths, such as `/infra/services/HTTPS`. Any service is matched " +
+				"if neither services nor ports are set",
+		},
+		"ports": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Protocol and ports matched by the rule",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"protocol": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: validations.StringInSlice([]string{"TCP", "UDP"}, false),
+						Description:      "L4 protocol. Supported values are `TCP` and `UDP`",
+					},
+					"destination_ports": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:             schema.TypeString,
+							ValidateDiagFunc: validations.ValidatePortRange,
+						},
+						Description: "Destination ports or port ranges, such as `443` or `8000-8080`",
+					},
+					"source_ports": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:             schema.TypeString,
+							ValidateDiagFunc: validations.ValidatePortRange,
+						},
+						Description: "Source ports or port ranges, such as `443` or `8000-8080`",
+					},
+				},
+			},
+		},
+	}
+}
@@ -27,7 +27,7 @@ func StaticNetworkSchema() *schema.Schema {
 				"pool_id": {
 					Type:        schema.TypeInt,
 					Optional:    true,
-					Description: "Pool ID can be obtained with " + DSNetworkPool + " data source.",
+					Description: "Pool ID can be obtained with " + DSNetworkPool + " data source or resource.",
 				},
 			},
 		},
@@ -67,9 +67,24 @@ func DhcpNetworkSchema() *schema.Schema {
 						"or the DHCP static-binding addresses of this segment",
 				},
 				"dhcp_range": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "DHCP server IP Address range",
+					Type:     schema.TypeString,
+					Required: true,
+					Description: "DHCP server IP Address range, e.g. `10.100.0.11-10.100.0.250`. The range must be " +
+						"within the cidr of the network and must not include the gateway.",
+				},
+				"dhcp_server_address_ipv6": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "DHCPv6 Server address and its CIDR, for dual stack networks. This address must " +
+						"not overlap the dhcp_range_ipv6 or the IPv6 gateway address of the subnet",
+					RequiredWith: []string{"dhcp_network.0.dhcp_range_ipv6"},
+				},
+				"dhcp_range_ipv6": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "DHCPv6 server IP Address range, e.g. `2001:db8::11-2001:db8::ff`. " +
+						"The range must be within the cidr_ipv6 of the network.",
+					RequiredWith: []string{"cidr_ipv6"},
 				},
 				"dhcp_lease_time": {
 					Type:        schema.TypeString,
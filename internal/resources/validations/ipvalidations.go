@@ -1,9 +1,10 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package validations
 
 import (
 	"fmt"
+	"net/netip"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -19,7 +20,7 @@ func errsTodiags(errs []error) diag.Diagnostics {
 	return d
 }
 
-// ValidateIPAddress Validates IP address
+// ValidateIPAddress Validates IPv4 address
 func ValidateIPAddress(i interface{}, p cty.Path) diag.Diagnostics {
 	if i == nil {
 		return nil
@@ -30,7 +31,45 @@ func ValidateIPAddress(i interface{}, p cty.Path) diag.Diagnostics {
 	return errsTodiags(errs)
 }
 
-// ValidateCidr validate cidr
+// ValidateDualStackIPAddress Validates IPv4 or IPv6 address. Use only for the
+// attributes which support IPv6.
+func ValidateDualStackIPAddress(i interface{}, p cty.Path) diag.Diagnostics {
+	if i == nil {
+		return nil
+	}
+
+	_, errs := validation.IsIPAddress(i, "")
+
+	return errsTodiags(errs)
+}
+
+// ValidateIPv6Address Validates IPv6 address
+func ValidateIPv6Address(i interface{}, p cty.Path) diag.Diagnostics {
+	if i == nil {
+		return nil
+	}
+
+	_, errs := validation.IsIPv6Address(i, "")
+
+	return errsTodiags(errs)
+}
+
+// ValidateIPv6Cidr validate IPv6 cidr
+func ValidateIPv6Cidr(i interface{}, p cty.Path) diag.Diagnostics {
+	if i == nil {
+		return nil
+	}
+
+	v, _ := i.(string)
+	prefix, err := netip.ParsePrefix(v)
+	if err != nil || !prefix.Addr().Is6() || prefix.Addr().Is4In6() {
+		return diag.Errorf("expected %s to contain a valid IPv6 CIDR", v)
+	}
+
+	return nil
+}
+
+// ValidateCidr validate IPv4 or IPv6 cidr
 func ValidateCidr(i interface{}, p cty.Path) diag.Diagnostics {
 	if i == nil {
 		return nil
@@ -41,7 +80,7 @@ func ValidateCidr(i interface{}, p cty.Path) diag.Diagnostics {
 	return errsTodiags(errs)
 }
 
-// ValidateIPorCidr validate cidr or IP Address
+// ValidateIPorCidr validate cidr or IPv4 Address
 func ValidateIPorCidr(i interface{}, p cty.Path) diag.Diagnostics {
 	var errors []error
 	if i == nil {
@@ -59,3 +98,23 @@ func ValidateIPorCidr(i interface{}, p cty.Path) diag.Diagnostics {
 
 	return errsTodiags(errors)
 }
+
+// ValidateDualStackIPorCidr validate IPv4 or IPv6 cidr or IP Address. Use only
+// for the attributes which support IPv6.
+func ValidateDualStackIPorCidr(i interface{}, p cty.Path) diag.Diagnostics {
+	var errors []error
+	if i == nil {
+		return nil
+	}
+
+	_, errsCidr := validation.IsCIDR(i, "")
+
+	if errsCidr != nil {
+		_, errsIP := validation.IsIPAddress(i, "")
+		if errsIP != nil {
+			errors = append(errors, fmt.Errorf("expected %s to contain a valid IP address or CIDR", i.(string)))
+		}
+	}
+
+	return errsTodiags(errors)
+}
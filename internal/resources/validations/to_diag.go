@@ -1,8 +1,15 @@
-// (C) Copyright 2021 Hewlett Packard Enterprise Development LP
+// (C) Copyright 2021-2024 Hewlett Packard Enterprise Development LP
 
 package validations
 
 import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -18,3 +25,82 @@ func IntBetween(min int, max int) schema.SchemaValidateDiagFunc {
 func IntAtLeast(min int) schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(validation.IntAtLeast(min))
 }
+
+// ValidateDuration validates a non negative duration, such as 5m or 1h30m
+func ValidateDuration(i interface{}, p cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of duration to be string")
+	}
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		return diag.Errorf("invalid duration %s: %v", v, err)
+	}
+	if duration < 0 {
+		return diag.Errorf("duration %s should not be negative", v)
+	}
+
+	return nil
+}
+
+// ValidatePortRange validates a port, such as 443, or a port range, such as
+// 8000-8080
+func ValidatePortRange(i interface{}, p cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of port to be string")
+	}
+	start, end, isRange := strings.Cut(v, "-")
+	if !isRange {
+		end = start
+	}
+	startPort, err1 := strconv.Atoi(start)
+	endPort, err2 := strconv.Atoi(end)
+	if err1 != nil || err2 != nil || startPort < 1 || endPort > 65535 || startPort > endPort {
+		return diag.Errorf("invalid port %s, expected a port or a port range between 1 and 65535", v)
+	}
+
+	return nil
+}
+
+// integerVerb matches a fmt integer verb with optional flags and width,
+// such as %d or %02d
+var integerVerb = regexp.MustCompile(`^%[-+ 0]*[0-9]*d`)
+
+// ValidateNamePattern validates a name pattern which is formatted with an
+// integer index. Pattern without '%' is valid, otherwise it should contain
+// exactly one integer verb, and '%%' for a literal '%'.
+func ValidateNamePattern(i interface{}, p cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of name pattern to be string")
+	}
+	if !strings.Contains(v, "%") {
+		return nil
+	}
+
+	verbs := 0
+	for idx := 0; idx < len(v); idx++ {
+		if v[idx] != '%' {
+			continue
+		}
+		if strings.HasPrefix(v[idx:], "%%") {
+			idx++
+
+			continue
+		}
+		verb := integerVerb.FindString(v[idx:])
+		if verb == "" {
+			return diag.Errorf("invalid name pattern %s, only integer verbs such as %%d or %%02d are "+
+				"supported, use %%%% for a literal %%", v)
+		}
+		verbs++
+		idx += len(verb) - 1
+	}
+	if verbs != 1 {
+		return diag.Errorf("invalid name pattern %s, expected exactly one integer verb such as %%d, got %d",
+			v, verbs)
+	}
+
+	return nil
+}
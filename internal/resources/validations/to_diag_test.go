@@ -0,0 +1,126 @@
+// (C) Copyright 2024 Hewlett Packard Enterprise Development LP
+
+package validations
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestValidatePortRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		port    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "Test case 1: single port",
+			port:    "443",
+			wantErr: false,
+		},
+		{
+			name:    "Test case 2: port range",
+			port:    "8080-8090",
+			wantErr: false,
+		},
+		{
+			name:    "Test case 3: full port range",
+			port:    "1-65535",
+			wantErr: false,
+		},
+		{
+			name:    "Test case 4: port 0",
+			port:    "0",
+			wantErr: true,
+		},
+		{
+			name:    "Test case 5: port out of range",
+			port:    "65536",
+			wantErr: true,
+		},
+		{
+			name:    "Test case 6: start after end",
+			port:    "8090-8080",
+			wantErr: true,
+		},
+		{
+			name:    "Test case 7: open range",
+			port:    "8080-",
+			wantErr: true,
+		},
+		{
+			name:    "Test case 8: not a number",
+			port:    "https",
+			wantErr: true,
+		},
+		{
+			name:    "Test case 9: not a string",
+			port:    443,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidatePortRange(tt.port, cty.Path{}); got.HasError() != tt.wantErr {
+				t.Errorf("ValidatePortRange() = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateNamePattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		wantErr bool
+	}{
+		{
+			name:    "Test case 1: pattern without verb",
+			pattern: "web",
+			wantErr: false,
+		},
+		{
+			name:    "Test case 2: integer verb",
+			pattern: "web-%d",
+			wantErr: false,
+		},
+		{
+			name:    "Test case 3: integer verb with width",
+			pattern: "web-%02d",
+			wantErr: false,
+		},
+		{
+			name:    "Test case 4: literal percent",
+			pattern: "50%%-%d",
+			wantErr: false,
+		},
+		{
+			name:    "Test case 5: string verb",
+			pattern: "web-%s",
+			wantErr: true,
+		},
+		{
+			name:    "Test case 6: unescaped percent",
+			pattern: "50%-%d",
+			wantErr: true,
+		},
+		{
+			name:    "Test case 7: multiple verbs",
+			pattern: "%d-%d",
+			wantErr: true,
+		},
+		{
+			name:    "Test case 8: literal percent only",
+			pattern: "50%%",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidateNamePattern(tt.pattern, cty.Path{}); got.HasError() != tt.wantErr {
+				t.Errorf("ValidateNamePattern() = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
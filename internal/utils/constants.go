@@ -35,4 +35,11 @@ const (
 	Deleted         = "deleted"
 	Failed          = "failed"
 	PortGroupPrefix = "dvportgroup-"
+	// instance action constants
+	ActionRestart      = "restart"
+	ActionReset        = "reset"
+	ActionEject        = "eject"
+	ActionWorkflow     = "workflow"
+	ActionTask         = "task"
+	ActionRefreshAgent = "refresh_agent"
 )
@@ -109,7 +109,8 @@ func (i InitialiseClient) NewClient(r *schema.ResourceData) (interface{}, error)
 	apiClient := api_client.NewAPIClient(&cfg)
 	morpheus_url := strings.TrimSpace(vmaasProviderSettings[constants.MORPHEUS_URL].(string))
 	morpheus_token := strings.TrimSpace(vmaasProviderSettings[constants.MORPHEUS_TOKEN].(string))
-	if morpheus_url != "" && morpheus_token != "" {
+	isMorpheusToken := morpheus_url != "" && morpheus_token != ""
+	if isMorpheusToken {
 		utils.SetMorpheusVars(apiClient, &cfg, morpheus_url, morpheus_token)
 	} else {
 		err = utils.SetCMPVars(apiClient, brokerApiClient, &cfg)
@@ -117,10 +118,15 @@ func (i InitialiseClient) NewClient(r *schema.ResourceData) (interface{}, error)
 			return nil, fmt.Errorf("[ERROR]: unable to set cmp metadata %v", err)
 		}
 	}
-	client.CmpClient = cmp_client.NewClient(apiClient, cfg)
+	pageSize, _ := vmaasProviderSettings[constants.PAGESIZE].(int)
+	client.CmpClient = cmp_client.NewClient(apiClient, cfg, pageSize, !isMorpheusToken)
 	utils.SetMetaFnAndVersion(brokerApiClient, r, apiClient.GetSCMVersion())
 
-	client.BrokerClient = cmp_client.NewBrokerClient(brokerApiClient, brokerCfgForAPIClient)
+	client.BrokerClient = cmp_client.NewBrokerClient(brokerApiClient, brokerCfgForAPIClient, cmp_client.BrokerDetails{
+		Location:  vmaasProviderSettings[constants.LOCATION].(string),
+		SpaceName: vmaasProviderSettings[constants.SPACENAME].(string),
+		TenantID:  tenantID,
+	})
 	return client, nil
 }
 
@@ -19,6 +19,7 @@ const (
 	INSECURE       = "allow_insecure"
 	MORPHEUS_URL   = "morpheus_url"
 	MORPHEUS_TOKEN = "morpheus_token"
+	PAGESIZE       = "page_size"
 	SpaceKey       = "space"
 	TenantIDKey    = "tenantID"
 	LocationKey    = "location"
@@ -4,6 +4,7 @@ package resources
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hewlettpackard/hpegl-provider-lib/pkg/registration"
 
@@ -51,6 +52,15 @@ func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 		resources.DSInstanceStorageType:       resources.ReadInstanceStorageType(),
 		resources.DSInstanceStorageController: resources.ReadInstanceStorageController(),
 		resources.DSMorpheusDataSource:        resources.MorpheusDetailsBroker(),
+		resources.DSNetworks:                  resources.NetworkListData(),
+		resources.DSPlans:                     resources.PlanListData(),
+		resources.DSTemplates:                 resources.TemplateListData(),
+		resources.DSDatastores:                resources.DatastoreListData(),
+		resources.DSClouds:                    resources.CloudListData(),
+		resources.DSGroups:                    resources.GroupListData(),
+		resources.DSPowerSchedules:            resources.PowerScheduleListData(),
+		resources.DSRouters:                   resources.RouterListData(),
+		resources.DSLoadBalancers:             resources.LoadBalancerListData(),
 	}
 }
 
@@ -58,10 +68,16 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		resources.ResInstance:                   resources.Instances(),
 		resources.ResInstanceClone:              resources.InstancesClone(),
+		resources.ResInstanceAction:             resources.InstanceAction(),
+		resources.ResInstanceGroup:              resources.InstanceGroup(),
 		resources.ResNetwork:                    resources.Network(),
 		resources.ResRouter:                     resources.Router(),
 		resources.ResRouterNat:                  resources.RouterNatRule(),
 		resources.ResRouterFirewallRuleGroup:    resources.RouterFirewallRuleGroup(),
+		resources.ResRouterFirewallRule:         resources.RouterFirewallRule(),
+		resources.ResDfwPolicy:                  resources.DistributedFirewallPolicy(),
+		resources.ResDfwRule:                    resources.DistributedFirewallRule(),
+		resources.ResNsxSecurityGroup:           resources.NsxSecurityGroup(),
 		resources.ResRouterRoute:                resources.RouterRoute(),
 		resources.ResRouterBgpNeighbor:          resources.RouterBgpNeighbor(),
 		resources.ResLoadBalancer:               resources.LoadBalancer(),
@@ -70,6 +86,10 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		resources.ResLoadBalancerPools:          resources.LoadBalancerPools(),
 		resources.ResLoadBalancerVirtualServers: resources.LoadBalancerVirtualServers(),
 		resources.ResDhcpServer:                 resources.DhcpServer(),
+		resources.ResNetworkPool:                resources.NetworkPool(),
+		resources.ResIPAddress:                  resources.IPAddress(),
+		resources.ResNetworkDomain:              resources.NetworkDomain(),
+		resources.ResDNSRecord:                  resources.DNSRecord(),
 	}
 }
 
@@ -112,6 +132,14 @@ func (r Registration) ProviderSchemaEntry() *schema.Resource {
 				DefaultFunc: schema.EnvDefaultFunc("INSECURE", false),
 				Description: "Not to be used in production. To perform client connection ignoring TLS, it can also be set with the INSECURE env var",
 			},
+			constants.PAGESIZE: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("HPEGL_VMAAS_PAGE_SIZE", 100),
+				ValidateFunc: validation.IntBetween(1, 1000),
+				Description: "Number of objects fetched per request from the CMP list APIs. Lower it if the " +
+					"list requests time out on large tenants, it can also be set with the HPEGL_VMAAS_PAGE_SIZE env var",
+			},
 		},
 	}
 }